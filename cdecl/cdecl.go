@@ -0,0 +1,472 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+// Package cdecl is a lightweight parser of C and C++ declarations, used to
+// create documentation skeletons matching the signatures found in headers.
+//
+// It recognizes function prototypes and definitions, function-like macros,
+// structs, unions, classes, enums and typedefs at the top level of the file,
+// and within `extern "C"` and namespace blocks. It does not expand macros.
+package cdecl
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+)
+
+type ErrUnbalanced struct {
+	Line int
+}
+
+func (err ErrUnbalanced) Error() string {
+	return fmt.Sprintf("unbalanced braces or parentheses at line %d", err.Line)
+}
+
+// Param is a single parameter of the function or macro.
+type Param struct {
+	Type string
+	// Name is empty for unnamed parameters.
+	Name string
+	// Direction is inferred from the type: `in` for values and pointers to
+	// const, `out` for other pointers, arrays and references, and empty for
+	// macros.
+	Direction string
+}
+
+// Decl is a single declaration found in the source.
+type Decl struct {
+	// Kind is one of command.KindFunction, command.KindDefine,
+	// command.KindStruct, command.KindUnion, command.KindClass,
+	// command.KindEnum and command.KindTypedef.
+	Kind string
+	Name string
+	// ReturnType is set for functions and function pointer typedefs, Type
+	// for other typedefs.
+	ReturnType string
+	Type       string
+	Params     []Param
+	// Start and End are byte offsets of the declaration in the source, Line
+	// is the line number of Start, counted from 1.
+	Start int
+	End   int
+	Line  int
+}
+
+// ReturnsVoid reports whether the function or function pointer typedef does
+// not return a value.
+func (d Decl) ReturnsVoid() bool {
+	fields := strings.Fields(d.ReturnType)
+	return len(fields) > 0 && fields[len(fields)-1] == "void"
+}
+
+var (
+	identRegexp     = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_:~]*$`)
+	defineRegexp    = regexp.MustCompile(`^#\s*define\s+([A-Za-z_][A-Za-z0-9_]*)\(([^)]*)\)`)
+	funcPtrRegexp   = regexp.MustCompile(`\(\s*[*&^]+\s*([A-Za-z_][A-Za-z0-9_]*)\s*\)\s*[(\[]`)
+	templateRegexp  = regexp.MustCompile(`^template\s*<`)
+	aggregateRegexp = regexp.MustCompile(`^(?:typedef\s+)?(struct|union|class|enum(?:\s+class|\s+struct)?)\b\s*([A-Za-z_][A-Za-z0-9_]*)?`)
+	transparent     = regexp.MustCompile(`^(?:extern\s*"[^"]*"|namespace(?:\s+[A-Za-z_][A-Za-z0-9_:]*)?|inline\s+namespace\s+[A-Za-z_][A-Za-z0-9_]*)$`)
+	specifierRegexp = regexp.MustCompile(`\b(?:extern|static|inline|virtual|explicit|constexpr|friend)\s+`)
+	attributeRegexp = regexp.MustCompile(`\b(?:__attribute__|__declspec)\s*\(|\[\[`)
+)
+
+// keywords are words that can not be names of parameters, and modifiers are
+// words that can not be types on their own, so that the type of unnamed
+// parameter is not mistaken for its name.
+var (
+	keywords = map[string]bool{
+		"bool": true, "char": true, "double": true, "float": true, "int": true,
+		"long": true, "short": true, "signed": true, "unsigned": true, "void": true,
+	}
+	modifiers = map[string]bool{
+		"const": true, "enum": true, "struct": true, "union": true, "volatile": true,
+	}
+)
+
+// Parse returns declarations found in the source, in order of appearance.
+func Parse(src []byte) ([]Decl, error) {
+	p := parser{src: string(src), code: []byte(blank(string(src)))}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.decls, nil
+}
+
+type parser struct {
+	src   string
+	code  []byte
+	decls []Decl
+}
+
+func (p *parser) line(offset int) int {
+	return strings.Count(p.src[:offset], "\n") + 1
+}
+
+func (p *parser) parse() error {
+	p.directives()
+
+	code := string(p.code)
+	start, depth := -1, 0
+	for i := 0; i < len(code); i++ {
+		ch := code[i]
+		if start < 0 {
+			if ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' {
+				continue
+			}
+			if ch == '}' && depth > 0 {
+				depth--
+				continue
+			}
+			start = i
+		}
+
+		switch ch {
+		case ';':
+			p.statement(start, i+1)
+			start = -1
+		case '{':
+			head := strings.Join(strings.Fields(code[start:i]), " ")
+			if transparent.MatchString(head) {
+				depth++
+				start = -1
+				continue
+			}
+			end, ok := match(code, i)
+			if !ok {
+				return ErrUnbalanced{Line: p.line(i)}
+			}
+			i = end
+			if isAggregate(head+"{") || strings.HasPrefix(head, "typedef") {
+				// Continue until the semicolon ending the declaration.
+				continue
+			}
+			p.statement(start, end+1)
+			start = -1
+		case '(', '[':
+			end, ok := match(code, i)
+			if !ok {
+				return ErrUnbalanced{Line: p.line(i)}
+			}
+			i = end
+		case '}', ')', ']':
+			return ErrUnbalanced{Line: p.line(i)}
+		}
+	}
+	if depth != 0 {
+		return ErrUnbalanced{Line: p.line(len(code))}
+	}
+
+	sortDecls(p.decls)
+	return nil
+}
+
+// directives records function-like macros and removes all preprocessor
+// directives from the code.
+func (p *parser) directives() {
+	atLineStart := true
+	for i := 0; i < len(p.code); i++ {
+		ch := p.code[i]
+		if ch == '\n' {
+			atLineStart = true
+			continue
+		}
+		if ch == ' ' || ch == '\t' {
+			continue
+		}
+		if !atLineStart || ch != '#' {
+			atLineStart = false
+			continue
+		}
+
+		end := i
+		for end < len(p.code) && (p.code[end] != '\n' || continued(p.code[:end])) {
+			end++
+		}
+		if m := defineRegexp.FindStringSubmatch(string(p.code[i:end])); m != nil {
+			d := Decl{Kind: command.KindDefine, Name: m[1], Start: i, End: end, Line: p.line(i)}
+			for _, name := range splitTop(m[2]) {
+				if name = strings.TrimSpace(name); name != "" {
+					d.Params = append(d.Params, Param{Name: name})
+				}
+			}
+			p.decls = append(p.decls, d)
+		}
+		for j := i; j < end; j++ {
+			if p.code[j] != '\n' {
+				p.code[j] = ' '
+			}
+		}
+		i = end - 1
+	}
+}
+
+// continued reports whether the line ending at the end of code continues on
+// the next line.
+func continued(code []byte) bool {
+	code = bytes.TrimSuffix(code, []byte("\r"))
+	return bytes.HasSuffix(code, []byte("\\"))
+}
+
+// statement classifies the code between start and end.
+func (p *parser) statement(start, end int) {
+	// Declaration of the template starts with its parameters, so that the
+	// documentation is placed before them.
+	code := string(p.code[start:end])
+	if templateRegexp.MatchString(code) {
+		if close, ok := matchAngle(code); ok {
+			code = code[close+1:]
+		}
+	}
+	text := strings.Join(strings.Fields(stripAttributes(strings.TrimRight(code, ";"))), " ")
+	d := Decl{Start: start, End: end, Line: p.line(start)}
+
+	if m := aggregateRegexp.FindStringSubmatch(text); m != nil && isAggregate(text) {
+		d.Kind = strings.Fields(m[1])[0]
+		d.Name = m[2]
+		if strings.HasPrefix(text, "typedef") {
+			if name := identRegexp.FindString(strings.TrimSpace(text[strings.LastIndex(text, "}")+1:])); name != "" {
+				d.Name = name
+			}
+		}
+		if d.Name != "" {
+			p.decls = append(p.decls, d)
+		}
+		return
+	}
+
+	if strings.HasPrefix(text, "typedef ") {
+		text = strings.TrimPrefix(text, "typedef ")
+		if m := funcPtrRegexp.FindStringSubmatchIndex(text); m != nil && text[m[1]-1] == '(' {
+			d.Kind = command.KindTypedef
+			d.Name = text[m[2]:m[3]]
+			d.ReturnType = strings.TrimSpace(text[:m[0]])
+			d.Params = params(text[m[1]:strings.LastIndex(text, ")")])
+		} else if name := identRegexp.FindString(text); name != "" {
+			d.Kind = command.KindTypedef
+			d.Name = name
+			d.Type = strings.TrimSpace(strings.TrimSuffix(text, name))
+		}
+		if d.Kind != "" {
+			p.decls = append(p.decls, d)
+		}
+		return
+	}
+
+	if body := strings.Index(text, "{"); body >= 0 {
+		text = text[:body]
+	}
+	open := strings.Index(text, "(")
+	close := strings.LastIndex(text, ")")
+	if open <= 0 || close < open || strings.Contains(text[:open], "=") {
+		return
+	}
+	name := identRegexp.FindString(strings.TrimSpace(text[:open]))
+	if name == "" || name == "operator" {
+		return
+	}
+	d.Kind = command.KindFunction
+	d.Name = name
+	d.ReturnType = strings.TrimSpace(specifierRegexp.ReplaceAllString(strings.TrimSuffix(strings.TrimSpace(text[:open]), name), ""))
+	if d.ReturnType == "" {
+		// Constructors, destructors and macro invocations.
+		return
+	}
+	if end, ok := match(text, open); ok {
+		d.Params = params(text[open+1 : end])
+	}
+	p.decls = append(p.decls, d)
+}
+
+// params parses comma separated list of parameters.
+func params(list string) []Param {
+	list = strings.TrimSpace(list)
+	if list == "" || list == "void" {
+		return nil
+	}
+
+	var ps []Param
+	for _, item := range splitTop(list) {
+		item = strings.TrimSpace(item)
+		if eq := strings.Index(item, "="); eq >= 0 {
+			item = strings.TrimSpace(item[:eq])
+		}
+		if item == "..." {
+			ps = append(ps, Param{Name: "..."})
+			continue
+		}
+
+		param := Param{Type: item}
+		if m := funcPtrRegexp.FindStringSubmatchIndex(item); m != nil {
+			param.Name = item[m[2]:m[3]]
+			param.Type = strings.TrimSpace(item[:m[2]] + item[m[3]:])
+		} else {
+			bare := strings.TrimSpace(strings.SplitN(item, "[", 2)[0])
+			name := identRegexp.FindString(bare)
+			typ := strings.TrimSpace(strings.TrimSuffix(bare, name))
+			if name != "" && !keywords[name] && !modifiers[typ] && typ != "" {
+				param.Name = name
+				param.Type = strings.TrimSpace(typ + " " + strings.TrimPrefix(item, bare))
+			}
+		}
+		param.Direction = direction(param.Type)
+		ps = append(ps, param)
+	}
+	return ps
+}
+
+// direction infers direction of the parameter from its type. Arrays are
+// treated as pointers.
+func direction(typ string) string {
+	ptr := strings.LastIndexAny(typ, "*&[")
+	if ptr < 0 || strings.HasSuffix(strings.TrimSpace(typ), "&&") || strings.Contains(typ, "(") {
+		return "in"
+	}
+	pointee := strings.Fields(strings.NewReplacer("*", " ", "&", " ").Replace(typ[:ptr]))
+	for _, f := range pointee {
+		if f == "const" {
+			return "in"
+		}
+	}
+	return "out"
+}
+
+// isAggregate reports whether the code is definition of struct, union, class
+// or enum, as opposed to function returning one of them.
+func isAggregate(code string) bool {
+	body := strings.Index(code, "{")
+	return body >= 0 && aggregateRegexp.MatchString(code) && !strings.Contains(code[:body], "(")
+}
+
+// blank returns the source with comments and contents of string and character
+// literals replaced by spaces. Newlines and offsets are preserved.
+func blank(src string) string {
+	b := []byte(src)
+	for i := 0; i < len(b); i++ {
+		switch {
+		case b[i] == '/' && i+1 < len(b) && b[i+1] == '/':
+			for ; i < len(b) && b[i] != '\n'; i++ {
+				b[i] = ' '
+			}
+		case b[i] == '/' && i+1 < len(b) && b[i+1] == '*':
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				end = len(src)
+			} else {
+				end += i + 4
+			}
+			for ; i < end; i++ {
+				if b[i] != '\n' {
+					b[i] = ' '
+				}
+			}
+			i--
+		case b[i] == '"' || b[i] == '\'':
+			quote := b[i]
+			for i++; i < len(b) && b[i] != quote && b[i] != '\n'; i++ {
+				if b[i] == '\\' && i+1 < len(b) {
+					b[i] = ' '
+					i++
+				}
+				b[i] = ' '
+			}
+		}
+	}
+	return string(b)
+}
+
+// stripAttributes returns the code without GNU, Microsoft and standard
+// attributes, which would be mistaken for parameter lists.
+func stripAttributes(code string) string {
+	for {
+		loc := attributeRegexp.FindStringIndex(code)
+		if loc == nil {
+			return code
+		}
+		end, ok := match(code, loc[1]-1)
+		if !ok {
+			return code
+		}
+		if code[loc[1]-1] == '[' {
+			end++
+		}
+		code = code[:loc[0]] + " " + code[end+1:]
+	}
+}
+
+// match returns index of the bracket closing the one at index i.
+func match(code string, i int) (int, bool) {
+	open := code[i]
+	close := map[byte]byte{'(': ')', '[': ']', '{': '}', '<': '>'}[open]
+	depth := 0
+	for ; i < len(code); i++ {
+		switch code[i] {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// matchAngle returns index of the bracket closing the template parameters.
+func matchAngle(code string) (int, bool) {
+	return match(code, strings.Index(code, "<"))
+}
+
+// splitTop splits the list on commas which are not nested in brackets.
+func splitTop(list string) []string {
+	var items []string
+	depth, start := 0, 0
+	for i := 0; i < len(list); i++ {
+		switch list[i] {
+		case '(', '[', '{', '<':
+			depth++
+		case ')', ']', '}', '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				items = append(items, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(items, list[start:])
+}
+
+func sortDecls(decls []Decl) {
+	sort.SliceStable(decls, func(i, j int) bool {
+		return decls[i].Start < decls[j].Start
+	})
+}
@@ -0,0 +1,187 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package cdecl_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/cdecl"
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+func parse(t *testing.T) map[string]cdecl.Decl {
+	t.Helper()
+	src, err := os.ReadFile("testdata/api.h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decls, err := cdecl.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := map[string]cdecl.Decl{}
+	for _, d := range decls {
+		byName[d.Name] = d
+	}
+	return byName
+}
+
+func TestParse(t *testing.T) {
+	decls := parse(t)
+
+	kinds := map[string]string{}
+	for name, d := range decls {
+		kinds[name] = d.Kind
+	}
+	want := map[string]string{
+		"API_MIN":      command.KindDefine,
+		"api_file":     command.KindTypedef,
+		"api_status":   command.KindEnum,
+		"api_options":  command.KindStruct,
+		"api_callback": command.KindTypedef,
+		"api_open":     command.KindFunction,
+		"api_read":     command.KindFunction,
+		"api_close":    command.KindFunction,
+		"api_version":  command.KindFunction,
+		"api_printf":   command.KindFunction,
+		"api_seek":     command.KindFunction,
+		"api_name":     command.KindFunction,
+		"api_reserved": command.KindFunction,
+	}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Errorf("got %v, want %v", kinds, want)
+	}
+
+	open := decls["api_open"]
+	params := []cdecl.Param{
+		{Type: "const char *", Name: "path", Direction: "in"},
+		{Type: "const struct api_options *", Name: "opts", Direction: "in"},
+		{Type: "api_file **", Name: "out", Direction: "out"},
+	}
+	if !reflect.DeepEqual(open.Params, params) || open.ReturnType != "api_status" || open.Line != 32 {
+		t.Errorf("unexpected declaration: %+v", open)
+	}
+	if !decls["api_close"].ReturnsVoid() || decls["api_read"].ReturnsVoid() {
+		t.Error("unexpected void returns")
+	}
+
+	seek := decls["api_seek"]
+	params = []cdecl.Param{
+		{Type: "api_file *", Name: "f", Direction: "out"},
+		{Type: "unsigned", Name: "flags", Direction: "in"},
+		{Type: "long", Name: "offset", Direction: "in"},
+		{Type: "short", Name: "whence", Direction: "in"},
+	}
+	if !reflect.DeepEqual(seek.Params, params) || seek.ReturnType != "int" {
+		t.Errorf("unexpected declaration: %+v", seek)
+	}
+
+	name := decls["api_name"]
+	params = []cdecl.Param{
+		{Type: "api_file *", Name: "f", Direction: "out"},
+		{Type: "char [64]", Name: "buf", Direction: "out"},
+		{Type: "const char [16]", Name: "prefix", Direction: "in"},
+	}
+	if !reflect.DeepEqual(name.Params, params) || name.ReturnType != "void" {
+		t.Errorf("unexpected declaration: %+v", name)
+	}
+
+	reserved := decls["api_reserved"]
+	params = []cdecl.Param{
+		{Type: "int", Direction: "in"},
+		{Type: "char *", Direction: "out"},
+		{Type: "unsigned long", Direction: "in"},
+	}
+	if !reflect.DeepEqual(reserved.Params, params) || reserved.ReturnType != "void" {
+		t.Errorf("unexpected declaration: %+v", reserved)
+	}
+}
+
+func TestParseCRLF(t *testing.T) {
+	decls, err := cdecl.Parse([]byte("#define M(a, b) \\\r\n  foo(a, \\\r\n  b)\r\nint g(int x);\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, d := range decls {
+		names = append(names, d.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"M", "g"}) {
+		t.Errorf("unexpected declarations: %v", names)
+	}
+	if g := decls[len(decls)-1]; g.Line != 4 {
+		t.Errorf("unexpected line of g: %d", g.Line)
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	decls := parse(t)
+
+	d := cdecl.Skeleton(decls["api_read"])
+	want := []command.Command{
+		command.Brief{},
+		command.Param{Direction: "out", ParameterName: "f"},
+		command.Param{Direction: "out", ParameterName: "buf"},
+		command.Param{Direction: "in", ParameterName: "len"},
+		command.Returns{},
+	}
+	if !reflect.DeepEqual(d.Commands, want) {
+		t.Errorf("got %v, want %v", d.Commands, want)
+	}
+
+	existing := doxygen.New(doxygen.WithMultipleCommands(
+		command.Brief{BriefDescription: "Closes the file."},
+		command.Param{ParameterName: "file", ParameterDescription: "Old name."},
+		command.Note{Text: "Idempotent."},
+	))
+	refreshed, stale := cdecl.Refresh(existing, decls["api_close"])
+	if !reflect.DeepEqual(stale, []string{"file"}) {
+		t.Errorf("unexpected stale parameters: %v", stale)
+	}
+	want = []command.Command{
+		command.Brief{BriefDescription: "Closes the file."},
+		command.Param{Direction: "out", ParameterName: "f"},
+		command.Note{Text: "Idempotent."},
+	}
+	if !reflect.DeepEqual(refreshed.Commands, want) {
+		t.Errorf("got %v, want %v", refreshed.Commands, want)
+	}
+
+	d = cdecl.Skeleton(decls["api_reserved"])
+	if !reflect.DeepEqual(d.Commands, []command.Command{command.Brief{}}) {
+		t.Errorf("unexpected skeleton of unnamed parameters: %v", d.Commands)
+	}
+	refreshed, _ = cdecl.Refresh(existing, decls["api_reserved"])
+	for _, cmd := range refreshed.Commands {
+		if _, ok := cmd.(command.Param); ok {
+			t.Errorf("unexpected parameter of unnamed parameters: %v", cmd)
+		}
+	}
+}
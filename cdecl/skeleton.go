@@ -0,0 +1,136 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package cdecl
+
+import (
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+// Skeleton returns documentation block for the declaration with empty brief
+// description, parameters in order of the signature, and empty description of
+// the return value unless the function returns void. Unnamed parameters can
+// not be documented and are skipped.
+func Skeleton(decl Decl) *doxygen.Doxygen {
+	d := doxygen.New(doxygen.WithCommand(command.Brief{}))
+	for _, param := range decl.Params {
+		if param.Name == "" {
+			continue
+		}
+		d.Commands = append(d.Commands, command.Param{
+			Direction:     param.Direction,
+			ParameterName: param.Name,
+		})
+	}
+	if decl.hasReturn() && !decl.ReturnsVoid() {
+		d.Commands = append(d.Commands, command.Returns{})
+	}
+	return d
+}
+
+func (d Decl) hasReturn() bool {
+	return d.Kind == command.KindFunction || d.Kind == command.KindTypedef && d.ReturnType != ""
+}
+
+// Stale returns names of parameters documented in the block, which are not
+// present in the declaration.
+func Stale(d *doxygen.Doxygen, decl Decl) []string {
+	names := map[string]bool{}
+	for _, param := range decl.Params {
+		names[param.Name] = true
+	}
+
+	var stale []string
+	for _, cmd := range d.Commands {
+		if param, ok := cmd.(command.Param); ok && !names[param.ParameterName] {
+			stale = append(stale, param.ParameterName)
+		}
+	}
+	return stale
+}
+
+// Refresh returns copy of the block with parameters matching the declaration.
+// Descriptions of existing parameters are kept, missing ones are added, and
+// stale ones are removed and returned. Parameters are ordered as in the
+// signature and placed where the first documented parameter was, other
+// commands keep their positions. Unnamed parameters are skipped.
+func Refresh(d *doxygen.Doxygen, decl Decl) (*doxygen.Doxygen, []string) {
+	existing := map[string]command.Param{}
+	for _, cmd := range d.Commands {
+		if param, ok := cmd.(command.Param); ok {
+			existing[param.ParameterName] = param
+		}
+	}
+
+	params := make([]command.Command, 0, len(decl.Params))
+	for _, param := range decl.Params {
+		if param.Name == "" {
+			continue
+		}
+		cmd, ok := existing[param.Name]
+		if !ok {
+			cmd = command.Param{ParameterName: param.Name}
+		}
+		if cmd.Direction == "" {
+			cmd.Direction = param.Direction
+		}
+		params = append(params, cmd)
+	}
+
+	refreshed := *d
+	refreshed.Commands = nil
+	inserted := false
+	for _, cmd := range d.Commands {
+		if _, ok := cmd.(command.Param); ok {
+			if !inserted {
+				refreshed.Commands = append(refreshed.Commands, params...)
+				inserted = true
+			}
+			continue
+		}
+		refreshed.Commands = append(refreshed.Commands, cmd)
+	}
+	if !inserted {
+		refreshed.Commands = insertParams(refreshed.Commands, params)
+	}
+	return &refreshed, Stale(d, decl)
+}
+
+// insertParams inserts parameters after the descriptions, before the first
+// command documenting the result or conditions.
+func insertParams(cmds, params []command.Command) []command.Command {
+	at := len(cmds)
+	for i, cmd := range cmds {
+		if doxygen.CanonicalRank(cmd) > doxygen.CanonicalRank(command.Param{}) {
+			at = i
+			break
+		}
+	}
+	out := append([]command.Command{}, cmds[:at]...)
+	out = append(out, params...)
+	return append(out, cmds[at:]...)
+}
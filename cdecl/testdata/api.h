@@ -0,0 +1,52 @@
+#ifndef API_H
+#define API_H
+
+#include <stddef.h>
+
+#define API_VERSION "1.0; (beta)"
+#define API_MIN(a, b) ((a) < (b) ? (a) : (b))
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+/** Handle of an open file. */
+typedef struct api_file api_file;
+
+typedef enum {
+	API_OK = 0,
+	API_ERROR = -1,
+} api_status;
+
+struct api_options {
+	int flags;
+	const char *mode; /* e.g. "r" or "w" */
+};
+
+typedef void (*api_callback)(void *ctx, int status);
+
+/**
+ * @brief Opens a file.
+ * @param path Path of the file.
+ */
+api_status api_open(const char *path, const struct api_options *opts, api_file **out);
+
+size_t api_read(api_file *f, void *buf, size_t len);
+
+void api_close(api_file *f);
+
+static inline int api_version(void) { return 1; }
+
+int api_printf(api_file *f, const char *fmt, ...);
+
+__attribute__((visibility("default"))) int api_seek(api_file *f, unsigned flags, long offset, short whence);
+
+__declspec(dllexport) void api_name(api_file *f, char buf[64], const char prefix[16]);
+
+[[deprecated]] void api_reserved(int, char *, unsigned long);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif
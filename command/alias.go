@@ -0,0 +1,187 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+type ErrArity struct {
+	Name string
+	Want int
+	Got  int
+}
+
+func (err ErrArity) Error() string {
+	return fmt.Sprintf("alias '%s' takes %d arguments, got %d", err.Name, err.Want, err.Got)
+}
+
+type ErrInvalidAlias struct {
+	Name   string
+	Reason string
+}
+
+func (err ErrInvalidAlias) Error() string {
+	return fmt.Sprintf("invalid alias '%s': %s", err.Name, err.Reason)
+}
+
+var (
+	aliasNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	aliasArgRegexp  = regexp.MustCompile(`\\([0-9]+)`)
+)
+
+// AliasDef is a custom command defined with the ALIASES configuration option.
+//
+// For more details, see: https://doxygen.nl/manual/custcmd.html
+type AliasDef struct {
+	Name string `json:"name"`
+	// Params is the number of arguments, referenced in the expansion as \1,
+	// \2 and so on.
+	Params    int    `json:"params"`
+	Expansion string `json:"expansion"`
+	// Inline aliases are emitted without a trailing newline, so they can be
+	// used within text.
+	Inline bool `json:"inline"`
+}
+
+// Validate checks the alias name and that the expansion does not reference
+// more arguments than the alias takes.
+func (def AliasDef) Validate() error {
+	if !aliasNameRegexp.MatchString(def.Name) {
+		return ErrInvalidAlias{Name: def.Name, Reason: "name must be a single identifier"}
+	}
+	if def.Params < 0 {
+		return ErrInvalidAlias{Name: def.Name, Reason: "negative number of parameters"}
+	}
+	for _, m := range aliasArgRegexp.FindAllStringSubmatch(def.Expansion, -1) {
+		if n, _ := strconv.Atoi(m[1]); n < 1 || n > def.Params {
+			return ErrInvalidAlias{Name: def.Name, Reason: fmt.Sprintf("expansion references argument \\%s", m[1])}
+		}
+	}
+	return nil
+}
+
+// Definition returns the alias in the form used by the ALIASES option, e.g.
+// `reqid{1}=\xrefitem reqs "Requirement" "Requirements" \1`. Newlines in the
+// expansion are replaced with `^^`.
+func (def AliasDef) Definition() string {
+	name := def.Name
+	if def.Params > 0 {
+		name += fmt.Sprintf("{%d}", def.Params)
+	}
+	return name + "=" + strings.ReplaceAll(def.Expansion, "\n", "^^")
+}
+
+// Command returns command invoking the alias with given arguments.
+func (def AliasDef) Command(args ...string) (Alias, error) {
+	if err := def.Validate(); err != nil {
+		return Alias{}, err
+	}
+	if len(args) != def.Params {
+		return Alias{}, ErrArity{Name: def.Name, Want: def.Params, Got: len(args)}
+	}
+	return Alias{Def: def, Args: args}, nil
+}
+
+// AliasDefinitions returns values of the ALIASES option for all definitions.
+func AliasDefinitions(defs ...AliasDef) []string {
+	lines := make([]string, 0, len(defs))
+	for _, def := range defs {
+		lines = append(lines, def.Definition())
+	}
+	return lines
+}
+
+// EscapeAliasArgument escapes commas and unbalanced braces, so that the text
+// is passed to the alias as a single argument.
+func EscapeAliasArgument(arg string) string {
+	balanced, depth := true, 0
+	for i := 0; i < len(arg); i++ {
+		switch {
+		case arg[i] == '\\':
+			i++
+		case arg[i] == '{':
+			depth++
+		case arg[i] == '}':
+			depth--
+			balanced = balanced && depth >= 0
+		}
+	}
+	balanced = balanced && depth == 0
+
+	sb := strings.Builder{}
+	for i := 0; i < len(arg); i++ {
+		switch ch := arg[i]; {
+		case ch == '\\' && i+1 < len(arg):
+			sb.WriteByte(ch)
+			sb.WriteByte(arg[i+1])
+			i++
+		case ch == ',':
+			sb.WriteString(`\,`)
+		case (ch == '{' || ch == '}') && !balanced:
+			sb.WriteByte('\\')
+			sb.WriteByte(ch)
+		default:
+			sb.WriteByte(ch)
+		}
+	}
+	return sb.String()
+}
+
+// Alias is an invocation of a custom command defined by AliasDef.
+type Alias struct {
+	Def  AliasDef `json:"def"`
+	Args []string `json:"args"`
+}
+
+func (cmd Alias) Command() string { return `Alias` }
+func (cmd Alias) Key() string     { return strings.Join(append([]string{cmd.Def.Name}, cmd.Args...), ",") }
+func (cmd Alias) Generate(tag string, out emitter.Emitter) {
+	if err := cmd.Def.Validate(); err != nil {
+		panic(err)
+	}
+	if len(cmd.Args) != cmd.Def.Params {
+		panic(ErrArity{Name: cmd.Def.Name, Want: cmd.Def.Params, Got: len(cmd.Args)})
+	}
+
+	out.Print("%s%s", tag, cmd.Def.Name)
+	if len(cmd.Args) > 0 {
+		args := make([]string, 0, len(cmd.Args))
+		for _, arg := range cmd.Args {
+			args = append(args, EscapeAliasArgument(arg))
+		}
+		out.Print("{%s}", strings.Join(args, ","))
+	}
+	if !cmd.Def.Inline {
+		out.Newline()
+	}
+}
@@ -28,6 +28,7 @@ package command
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/shanduur/go-doxygen-generator/emitter"
 )
@@ -41,19 +42,19 @@ type Command interface {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmda
 type A struct {
-	Word string
+	Word string `json:"word"`
 }
 
 func (cmd A) Command() string { return `A` }
 func (cmd A) Generate(tag string, out emitter.Emitter) {
-	out.Print("%sa %s", tag, word(cmd.Word))
+	inline(tag, out, "a", cmd.Word)
 }
 
 // Addindex is structure for `addindex` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdaddindex
 type Addindex struct {
-	Text string
+	Text string `json:"text"`
 }
 
 func (cmd Addindex) Command() string { return `Addindex` }
@@ -65,8 +66,8 @@ func (cmd Addindex) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdaddtogroup
 type Addtogroup struct {
-	Name  string
-	Title string
+	Name  string `json:"name"`
+	Title string `json:"title"`
 }
 
 func (cmd Addtogroup) Command() string { return `Addtogroup` }
@@ -78,11 +79,12 @@ func (cmd Addtogroup) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdanchor
 type Anchor struct {
-	Name string
-	Text string
+	Name string `json:"name"`
+	Text string `json:"text"`
 }
 
 func (cmd Anchor) Command() string { return `Anchor` }
+func (cmd Anchor) Key() string     { return cmd.Name }
 func (cmd Anchor) Generate(tag string, out emitter.Emitter) {
 	out.Println("%sanchor %s%s", tag, word(cmd.Name), optional(cmd.Text))
 }
@@ -91,7 +93,7 @@ func (cmd Anchor) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdarg
 type Arg struct {
-	ItemDescription string
+	ItemDescription string `json:"itemDescription"`
 }
 
 func (cmd Arg) Command() string { return `Arg` }
@@ -103,7 +105,7 @@ func (cmd Arg) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdattention
 type Attention struct {
-	Text string
+	Text string `json:"text"`
 }
 
 func (cmd Attention) Command() string { return `Attention` }
@@ -115,7 +117,7 @@ func (cmd Attention) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdauthor
 type Author struct {
-	ListOfAuthors []string
+	ListOfAuthors []string `json:"listOfAuthors"`
 }
 
 func (cmd Author) Command() string { return `Author` }
@@ -129,7 +131,7 @@ func (cmd Author) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdauthors
 type Authors struct {
-	ListOfAuthors []string
+	ListOfAuthors []string `json:"listOfAuthors"`
 }
 
 func (cmd Authors) Command() string { return `Authors` }
@@ -143,31 +145,31 @@ func (cmd Authors) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdb
 type B struct {
-	Word string
+	Word string `json:"word"`
 }
 
 func (cmd B) Command() string { return `B` }
 func (cmd B) Generate(tag string, out emitter.Emitter) {
-	out.Print("%sarg %s", tag, word(cmd.Word))
+	inline(tag, out, "b", cmd.Word)
 }
 
 // MultiB is structure for multi word `b` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdb
 type MultiB struct {
-	Text string
+	Text string `json:"text"`
 }
 
 func (cmd MultiB) Command() string { return `MultiB` }
 func (cmd MultiB) Generate(tag string, out emitter.Emitter) {
-	out.Print("<b>%s</b>", cmd.Text)
+	multiInline(out, "b", cmd.Text)
 }
 
 // Brief is structure for `brief` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdbrief
 type Brief struct {
-	BriefDescription string
+	BriefDescription string `json:"briefDescription"`
 }
 
 func (cmd Brief) Command() string { return `Brief` }
@@ -179,7 +181,7 @@ func (cmd Brief) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdbug
 type Bug struct {
-	Description string
+	Description string `json:"description"`
 }
 
 func (cmd Bug) Command() string { return `Bug` }
@@ -191,12 +193,12 @@ func (cmd Bug) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdc
 type C struct {
-	Word string
+	Word string `json:"word"`
 }
 
 func (cmd C) Command() string { return `C` }
 func (cmd C) Generate(tag string, out emitter.Emitter) {
-	out.Print("%sc %s", tag, word(cmd.Word))
+	inline(tag, out, "c", cmd.Word)
 }
 
 // Callergraph is structure for `callergraph` command.
@@ -223,9 +225,9 @@ func (cmd Callgraph) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdcategory
 type Category struct {
-	Name       string
-	HeaderFile string
-	HeaderName string
+	Name       string `json:"name"`
+	HeaderFile string `json:"headerFile"`
+	HeaderName string `json:"headerName"`
 }
 
 func (cmd Category) Command() string { return `Category` }
@@ -240,10 +242,11 @@ func (cmd Category) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdcite
 type Cite struct {
-	Label string
+	Label string `json:"label"`
 }
 
 func (cmd Cite) Command() string { return `Cite` }
+func (cmd Cite) Key() string     { return cmd.Label }
 func (cmd Cite) Generate(tag string, out emitter.Emitter) {
 	out.Println("%scite %s", tag, word(cmd.Label))
 }
@@ -252,9 +255,9 @@ func (cmd Cite) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdclass
 type Class struct {
-	Name       string
-	HeaderFile string
-	HeaderName string
+	Name       string `json:"name"`
+	HeaderFile string `json:"headerFile"`
+	HeaderName string `json:"headerName"`
 }
 
 func (cmd Class) Command() string { return `Class` }
@@ -262,22 +265,27 @@ func (cmd Class) Generate(tag string, out emitter.Emitter) {
 	out.Println("%sclass %s%s%s", tag,
 		word(cmd.Name),
 		optional(word(cmd.HeaderFile)),
-		optional(word(cmd.HeaderFile)))
+		optional(word(cmd.HeaderName)))
 }
 
 // Code is structure for `code` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdcode
 type Code struct {
-	Word      string
-	CodeBlock string
+	// Word is the language hint, such as `py` or `.c`.
+	Word      string `json:"word"`
+	CodeBlock string `json:"codeBlock"`
+	// Lines are used instead of CodeBlock when set.
+	Lines []string `json:"lines"`
 }
 
 func (cmd Code) Command() string { return `Code` }
 func (cmd Code) Generate(tag string, out emitter.Emitter) {
-	out.Println("%scode", tag,
-		optionalf(" {%s}", word(cmd.Word)))
-	out.Println("%s", cmd.CodeBlock)
+	body := block(cmd.CodeBlock, cmd.Lines)
+	guard(cmd.Command(), body, "endcode")
+
+	out.Println("%scode%s", tag, optionalf("{.%s}", strings.TrimPrefix(word(cmd.Word), ".")))
+	emitter.PrintRaw(out, body)
 	Endcode{}.Generate(tag, out)
 }
 
@@ -285,7 +293,7 @@ func (cmd Code) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdconcept
 type Concept struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Concept) Command() string { return `Concept` }
@@ -297,7 +305,7 @@ func (cmd Concept) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdcond
 type Cond struct {
-	SectionLabel string
+	SectionLabel string `json:"sectionLabel"`
 }
 
 func (cmd Cond) Command() string { return `Cond` }
@@ -309,7 +317,7 @@ func (cmd Cond) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdcopybrief
 type Copybrief struct {
-	LinkObject string
+	LinkObject string `json:"linkObject"`
 }
 
 func (cmd Copybrief) Command() string { return `Copybrief` }
@@ -321,7 +329,7 @@ func (cmd Copybrief) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdcopydetails
 type Copydetails struct {
-	LinkObject string
+	LinkObject string `json:"linkObject"`
 }
 
 func (cmd Copydetails) Command() string { return `Copydetails` }
@@ -333,7 +341,7 @@ func (cmd Copydetails) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdcopydoc
 type Copydoc struct {
-	LinkObject string
+	LinkObject string `json:"linkObject"`
 }
 
 func (cmd Copydoc) Command() string { return `Copydoc` }
@@ -345,7 +353,7 @@ func (cmd Copydoc) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdcopyright
 type Copyright struct {
-	Description string
+	Description string `json:"description"`
 }
 
 func (cmd Copyright) Command() string { return `Copyright` }
@@ -357,7 +365,7 @@ func (cmd Copyright) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmddate
 type Date struct {
-	Description string
+	Description string `json:"description"`
 }
 
 func (cmd Date) Command() string { return `Date` }
@@ -369,7 +377,7 @@ func (cmd Date) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmddef
 type Def struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Def) Command() string { return `Def` }
@@ -381,8 +389,8 @@ func (cmd Def) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmddefgroup
 type Defgroup struct {
-	Name       string
-	GroupTitle string
+	Name       string `json:"name"`
+	GroupTitle string `json:"groupTitle"`
 }
 
 func (cmd Defgroup) Command() string { return `Defgroup` }
@@ -394,7 +402,7 @@ func (cmd Defgroup) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmddeprecated
 type Deprecated struct {
-	Description string
+	Description string `json:"description"`
 }
 
 func (cmd Deprecated) Command() string { return `Deprecated` }
@@ -406,7 +414,7 @@ func (cmd Deprecated) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmddetails
 type Details struct {
-	DetailedDescription string
+	DetailedDescription string `json:"detailedDescription"`
 }
 
 func (cmd Details) Command() string { return `Details` }
@@ -418,10 +426,10 @@ func (cmd Details) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmddiafile
 type Diafile struct {
-	File           string
-	Caption        string
-	SizeIndication string
-	Size           string
+	File           string `json:"file"`
+	Caption        string `json:"caption"`
+	SizeIndication string `json:"sizeIndication"`
+	Size           string `json:"size"`
 }
 
 func (cmd Diafile) Command() string { return `Diafile` }
@@ -441,7 +449,7 @@ func (cmd Diafile) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmddir
 type Dir struct {
-	PathFragment string
+	PathFragment string `json:"pathFragment"`
 }
 
 func (cmd Dir) Command() string { return `Dir` }
@@ -478,12 +486,12 @@ type Dotfile struct{}
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmde
 type E struct {
-	Word string
+	Word string `json:"word"`
 }
 
 func (cmd E) Command() string { return `E` }
 func (cmd E) Generate(tag string, out emitter.Emitter) {
-	out.Print("%se %s", tag, word(cmd.Word))
+	inline(tag, out, "e", cmd.Word)
 }
 
 // Else is structure for `else` command.
@@ -500,31 +508,31 @@ type Elseif struct{}
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdem
 type Em struct {
-	Word string
+	Word string `json:"word"`
 }
 
 func (cmd Em) Command() string { return `Em` }
 func (cmd Em) Generate(tag string, out emitter.Emitter) {
-	out.Print("%sEm %s", tag, word(cmd.Word))
+	inline(tag, out, "em", cmd.Word)
 }
 
 // MultiEm is structure for multi word `em` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdem
 type MultiEm struct {
-	Text string
+	Text string `json:"text"`
 }
 
 func (cmd MultiEm) Command() string { return `MultiEm` }
 func (cmd MultiEm) Generate(tag string, out emitter.Emitter) {
-	out.Print("<em>%s</em>", cmd.Text)
+	multiInline(out, "em", cmd.Text)
 }
 
 // Emoji is structure for `emoji` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdemoji
 type Emoji struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Emoji) Command() string { return `Emoji` }
@@ -583,6 +591,11 @@ type Endlatexonly struct{}
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdendlink
 type Endlink struct{}
 
+func (cmd Endlink) Command() string { return `Endlink` }
+func (cmd Endlink) Generate(tag string, out emitter.Emitter) {
+	out.Print("%sendlink", tag)
+}
+
 // Endmanonly is structure for `endmanonly` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdendmanonly
@@ -612,13 +625,25 @@ type Endrtfonly struct{}
 // Endsecreflist is structure for `endsecreflist` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdendsecreflist
+// This should not be used by itself!
 type Endsecreflist struct{}
 
+func (cmd Endsecreflist) Command() string { return `Endsecreflist` }
+func (cmd Endsecreflist) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sendsecreflist", tag)
+}
+
 // Endverbatim is structure for `endverbatim` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdendverbatim
+// This should not be used by itself!
 type Endverbatim struct{}
 
+func (cmd Endverbatim) Command() string { return `Endverbatim` }
+func (cmd Endverbatim) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sendverbatim", tag)
+}
+
 // Enduml is structure for `enduml` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdenduml
@@ -633,7 +658,7 @@ type Endxmlonly struct{}
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdenum
 type Enum struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Enum) Command() string { return `Enum` }
@@ -650,11 +675,12 @@ type Example struct{}
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdexception
 type Exception struct {
-	ExceptionObject      string
-	ExceptionDescription string
+	ExceptionObject      string `json:"exceptionObject"`
+	ExceptionDescription string `json:"exceptionDescription"`
 }
 
 func (cmd Exception) Command() string { return `Exception` }
+func (cmd Exception) Key() string     { return cmd.ExceptionObject }
 func (cmd Exception) Generate(tag string, out emitter.Emitter) {
 	out.Println("%sexception %s %s", tag, word(cmd.ExceptionObject), cmd.ExceptionDescription)
 }
@@ -663,7 +689,7 @@ func (cmd Exception) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdextends
 type Extends struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Extends) Command() string { return `Extends` }
@@ -676,41 +702,78 @@ func (cmd Extends) Generate(tag string, out emitter.Emitter) {
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdfrndopen
 type FParanthesesLeft struct{}
 
+func (cmd FParanthesesLeft) Command() string { return `FParanthesesLeft` }
+func (cmd FParanthesesLeft) Generate(tag string, out emitter.Emitter) {
+	out.Print("%sf(", tag)
+}
+
 // FParanthesesRight is structure for `f)` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdfrndclose
 type FParanthesesRight struct{}
 
+func (cmd FParanthesesRight) Command() string { return `FParanthesesRight` }
+func (cmd FParanthesesRight) Generate(tag string, out emitter.Emitter) {
+	out.Print("%sf)", tag)
+}
+
 // FDollar is structure for `f$` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdfdollar
 type FDollar struct{}
 
+func (cmd FDollar) Command() string { return `FDollar` }
+func (cmd FDollar) Generate(tag string, out emitter.Emitter) {
+	out.Print("%sf$", tag)
+}
+
 // FBracketLeft is structure for `f[` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdfbropen
 type FBracketLeft struct{}
 
+func (cmd FBracketLeft) Command() string { return `FBracketLeft` }
+func (cmd FBracketLeft) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sf[", tag)
+}
+
 // FBracketRight is structure for `f]` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdfbrclose
 type FBracketRight struct{}
 
+func (cmd FBracketRight) Command() string { return `FBracketRight` }
+func (cmd FBracketRight) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sf]", tag)
+}
+
 // FBracesLeft is structure for `f{` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdfcurlyopen
-type FBracesLeft struct{}
+type FBracesLeft struct {
+	Environment string `json:"environment"`
+}
+
+func (cmd FBracesLeft) Command() string { return `FBracesLeft` }
+func (cmd FBracesLeft) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sf{%s}{", tag, word(cmd.Environment))
+}
 
 // FBracesRigt is structure for `f}` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdfcurlyclose
 type FBracesRigt struct{}
 
+func (cmd FBracesRigt) Command() string { return `FBracesRigt` }
+func (cmd FBracesRigt) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sf}", tag)
+}
+
 // File is structure for `file` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdfile
 type File struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd File) Command() string { return `File` }
@@ -726,14 +789,29 @@ type Fileinfo struct{}
 // Fn is structure for `fn` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdfn
-type Fn struct{}
+type Fn struct {
+	ReturnType string   `json:"returnType"`
+	Name       string   `json:"name"`
+	Parameters []string `json:"parameters"`
+	Qualifiers string   `json:"qualifiers"`
+}
+
+func (cmd Fn) Command() string { return `Fn` }
+func (cmd Fn) Key() string     { return cmd.Name }
+func (cmd Fn) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sfn %s%s(%s)%s", tag,
+		optionalf("%s ", cmd.ReturnType),
+		identifier(cmd.Name),
+		strings.Join(cmd.Parameters, ", "),
+		optional(cmd.Qualifiers))
+}
 
 // HeaderFile is structure for `HeaderFile` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdheaderfile
 type HeaderFile struct {
-	File string
-	Name string
+	File string `json:"file"`
+	Name string `json:"name"`
 }
 
 func (cmd HeaderFile) Command() string { return `HeaderFile` }
@@ -780,7 +858,7 @@ type Htmlonly struct{}
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdidlexcept
 type Idlexcept struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Idlexcept) Command() string { return `Idlexcept` }
@@ -801,13 +879,57 @@ type Ifnot struct{}
 // Image is structure for `image` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdimage
-type Image struct{}
+type Image struct {
+	Format  string `json:"format"`
+	File    string `json:"file"`
+	Caption string `json:"caption"`
+	Width   string `json:"width"`
+	Height  string `json:"height"`
+	Inline  bool   `json:"inline"`
+}
+
+// Images returns one image command per format for the same file. All output
+// formats are used when none is given.
+func Images(file, caption string, formats ...string) []Image {
+	if len(formats) == 0 {
+		formats = ImageFormats
+	}
+	images := make([]Image, 0, len(formats))
+	for _, format := range formats {
+		images = append(images, Image{Format: format, File: file, Caption: caption})
+	}
+	return images
+}
+
+func (cmd Image) Command() string { return `Image` }
+func (cmd Image) Key() string     { return cmd.Format + " " + cmd.File }
+func (cmd Image) Generate(tag string, out emitter.Emitter) {
+	if !validImageFormat(cmd.Format) {
+		panic(ErrInvalidImageFormat{Format: cmd.Format})
+	}
+
+	file := cmd.File
+	if strings.ContainsAny(file, " \t") {
+		file = fmt.Sprintf(`"%s"`, file)
+	}
+
+	opts := ""
+	if cmd.Inline {
+		opts = "{inline}"
+	}
+
+	out.Println("%simage%s %s %s%s%s%s", tag, opts, cmd.Format, file,
+		optionalf(` "%s"`, cmd.Caption),
+		optionalf(" width=%s", cmd.Width),
+		optionalf(" height=%s", cmd.Height),
+	)
+}
 
 // Implements is structure for `implements` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdimplements
 type Implements struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Implements) Command() string { return `Implements` }
@@ -833,7 +955,18 @@ type Includelineno struct{}
 // Ingroup is structure for `ingroup` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdingroup
-type Ingroup struct{}
+type Ingroup struct {
+	Groups []string `json:"groups"`
+}
+
+func (cmd Ingroup) Command() string { return `Ingroup` }
+func (cmd Ingroup) Generate(tag string, out emitter.Emitter) {
+	out.Print("%singroup", tag)
+	for _, group := range cmd.Groups {
+		out.Print(" %s", word(group))
+	}
+	out.Newline()
+}
 
 // Internal is structure for `internal` command.
 //
@@ -843,12 +976,31 @@ type Internal struct{}
 // Invariant is structure for `invariant` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdinvariant
-type Invariant struct{}
+type Invariant struct {
+	Description string `json:"description"`
+}
+
+func (cmd Invariant) Command() string { return `Invariant` }
+func (cmd Invariant) Generate(tag string, out emitter.Emitter) {
+	describe(tag, out, tag+"invariant", cmd.Description)
+}
 
 // Interface is structure for `interface` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdinterface
-type Interface struct{}
+type Interface struct {
+	Name       string `json:"name"`
+	HeaderFile string `json:"headerFile"`
+	HeaderName string `json:"headerName"`
+}
+
+func (cmd Interface) Command() string { return `Interface` }
+func (cmd Interface) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sinterface %s%s%s", tag,
+		identifier(cmd.Name),
+		optional(word(cmd.HeaderFile)),
+		optional(word(cmd.HeaderName)))
+}
 
 // Latexinclude is structure for `latexinclude` command.
 //
@@ -863,7 +1015,14 @@ type Latexonly struct{}
 // Li is structure for `li` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdli
-type Li struct{}
+type Li struct {
+	ItemDescription string `json:"itemDescription"`
+}
+
+func (cmd Li) Command() string { return `Li` }
+func (cmd Li) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sli %s", tag, cmd.ItemDescription)
+}
 
 // Line is structure for `line` command.
 //
@@ -878,12 +1037,28 @@ type Lineinfo struct{}
 // Link is structure for `link` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdlink
-type Link struct{}
+type Link struct {
+	LinkObject string `json:"linkObject"`
+	Text       string `json:"text"`
+}
+
+func (cmd Link) Command() string { return `Link` }
+func (cmd Link) Generate(tag string, out emitter.Emitter) {
+	out.Print("%slink %s%s ", tag, word(cmd.LinkObject), optional(cmd.Text))
+	Endlink{}.Generate(tag, out)
+}
 
 // Mainpage is structure for `mainpage` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdmainpage
-type Mainpage struct{}
+type Mainpage struct {
+	Title string `json:"title"`
+}
+
+func (cmd Mainpage) Command() string { return `Mainpage` }
+func (cmd Mainpage) Generate(tag string, out emitter.Emitter) {
+	out.Println("%smainpage%s", tag, optional(cmd.Title))
+}
 
 // Maninclude is structure for `maninclude` command.
 //
@@ -899,7 +1074,7 @@ type Manonly struct{}
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdmemberof
 type Memberof struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Memberof) Command() string { return `Memberof` }
@@ -922,16 +1097,28 @@ type Mscfile struct{}
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdn
 type N struct{}
 
+func (cmd N) Command() string { return `N` }
+func (cmd N) Generate(tag string, out emitter.Emitter) {
+	out.Print("%sn", tag)
+}
+
 // Name is structure for `name` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdname
-type Name struct{}
+type Name struct {
+	Header string `json:"header"`
+}
+
+func (cmd Name) Command() string { return `Name` }
+func (cmd Name) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sname%s", tag, optional(cmd.Header))
+}
 
 // Namespace is structure for `namespace` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdnamespace
 type Namespace struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Namespace) Command() string { return `Namespace` }
@@ -943,7 +1130,7 @@ func (cmd Namespace) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdnoop
 type Noop struct {
-	IgnoredText string
+	IgnoredText string `json:"ignoredText"`
 }
 
 func (cmd Noop) Command() string { return `Noop` }
@@ -956,10 +1143,22 @@ func (cmd Noop) Generate(tag string, out emitter.Emitter) {
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdnosubgrouping
 type Nosubgrouping struct{}
 
+func (cmd Nosubgrouping) Command() string { return `Nosubgrouping` }
+func (cmd Nosubgrouping) Generate(tag string, out emitter.Emitter) {
+	out.Println("%snosubgrouping", tag)
+}
+
 // Note is structure for `note` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdnote
-type Note struct{}
+type Note struct {
+	Text string `json:"text"`
+}
+
+func (cmd Note) Command() string { return `Note` }
+func (cmd Note) Generate(tag string, out emitter.Emitter) {
+	describe(tag, out, tag+"note", cmd.Text)
+}
 
 // Overload is structure for `overload` command.
 //
@@ -969,13 +1168,20 @@ type Overload struct{}
 // P is structure for `p` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdp
-type P struct{}
+type P struct {
+	Word string `json:"word"`
+}
+
+func (cmd P) Command() string { return `P` }
+func (cmd P) Generate(tag string, out emitter.Emitter) {
+	inline(tag, out, "p", cmd.Word)
+}
 
 // Package is structure for `package` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdpackage
 type Package struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Package) Command() string { return `Package` }
@@ -986,14 +1192,22 @@ func (cmd Package) Generate(tag string, out emitter.Emitter) {
 // Page is structure for `page` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdpage
-type Page struct{}
+type Page struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+func (cmd Page) Command() string { return `Page` }
+func (cmd Page) Generate(tag string, out emitter.Emitter) {
+	out.Println("%spage %s%s", tag, word(cmd.Name), optional(cmd.Title))
+}
 
 // Par is structure for `par` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdpar
 type Par struct {
-	Title     string
-	Paragraph string
+	Title     string `json:"title"`
+	Paragraph string `json:"paragraph"`
 }
 
 func (cmd Par) Command() string { return `Par` }
@@ -1009,8 +1223,8 @@ func (cmd Par) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdparagraph
 type Paragraph struct {
-	Name  string
-	Title string
+	Name  string `json:"name"`
+	Title string `json:"title"`
 }
 
 func (cmd Paragraph) Command() string { return `Paragraph` }
@@ -1022,12 +1236,13 @@ func (cmd Paragraph) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdparam
 type Param struct {
-	Direction            string
-	ParameterName        string
-	ParameterDescription string
+	Direction            string `json:"direction"`
+	ParameterName        string `json:"parameterName"`
+	ParameterDescription string `json:"parameterDescription"`
 }
 
 func (cmd Param) Command() string { return `Param` }
+func (cmd Param) Key() string     { return cmd.ParameterName }
 func (cmd Param) Generate(tag string, out emitter.Emitter) {
 	out.Print("%sparam", tag)
 	if cmd.Direction != "" && cmd.directionValid() {
@@ -1049,7 +1264,7 @@ func (cmd Param) directionValid() bool {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdparblock
 type Parblock struct {
-	Paragraphs []string
+	Paragraphs []string `json:"paragraphs"`
 }
 
 func (cmd Parblock) Command() string { return `Parblock` }
@@ -1058,7 +1273,7 @@ func (cmd Parblock) Generate(tag string, out emitter.Emitter) {
 	defer Endparblock{}.Generate(tag, out)
 
 	for i := 0; i < len(cmd.Paragraphs); i++ {
-		out.Println("%s", cmd.Paragraphs[i])
+		lines(out, cmd.Paragraphs[i])
 		if i < len(cmd.Paragraphs)-1 {
 			out.Newline()
 		}
@@ -1068,53 +1283,117 @@ func (cmd Parblock) Generate(tag string, out emitter.Emitter) {
 // Post is structure for `post` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdpost
-type Post struct{}
+type Post struct {
+	Description string `json:"description"`
+}
+
+func (cmd Post) Command() string { return `Post` }
+func (cmd Post) Generate(tag string, out emitter.Emitter) {
+	describe(tag, out, tag+"post", cmd.Description)
+}
 
 // Pre is structure for `pre` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdpre
-type Pre struct{}
+type Pre struct {
+	Description string `json:"description"`
+}
+
+func (cmd Pre) Command() string { return `Pre` }
+func (cmd Pre) Generate(tag string, out emitter.Emitter) {
+	describe(tag, out, tag+"pre", cmd.Description)
+}
 
 // Private is structure for `private` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdprivate
 type Private struct{}
 
+func (cmd Private) Command() string { return `Private` }
+func (cmd Private) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sprivate", tag)
+}
+
 // Privatesection is structure for `privatesection` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdprivatesection
 type Privatesection struct{}
 
+func (cmd Privatesection) Command() string { return `Privatesection` }
+func (cmd Privatesection) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sprivatesection", tag)
+}
+
 // Property is structure for `property` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdproperty
-type Property struct{}
+type Property struct {
+	Datatype string `json:"datatype"`
+	Name     string `json:"name"`
+}
+
+func (cmd Property) Command() string { return `Property` }
+func (cmd Property) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sproperty %s%s", tag, optionalf("%s ", cmd.Datatype), identifier(cmd.Name))
+}
 
 // Protected is structure for `protected` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdprotected
 type Protected struct{}
 
+func (cmd Protected) Command() string { return `Protected` }
+func (cmd Protected) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sprotected", tag)
+}
+
 // Protectedsection is structure for `protectedsection` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdprotectedsection
 type Protectedsection struct{}
 
+func (cmd Protectedsection) Command() string { return `Protectedsection` }
+func (cmd Protectedsection) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sprotectedsection", tag)
+}
+
 // Protocol is structure for `protocol` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdprotocol
-type Protocol struct{}
+type Protocol struct {
+	Name       string `json:"name"`
+	HeaderFile string `json:"headerFile"`
+	HeaderName string `json:"headerName"`
+}
+
+func (cmd Protocol) Command() string { return `Protocol` }
+func (cmd Protocol) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sprotocol %s%s%s", tag,
+		identifier(cmd.Name),
+		optional(word(cmd.HeaderFile)),
+		optional(word(cmd.HeaderName)))
+}
 
 // Public is structure for `public` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdpublic
 type Public struct{}
 
+func (cmd Public) Command() string { return `Public` }
+func (cmd Public) Generate(tag string, out emitter.Emitter) {
+	out.Println("%spublic", tag)
+}
+
 // Publicsection is structure for `publicsection` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdpublicsection
 type Publicsection struct{}
 
+func (cmd Publicsection) Command() string { return `Publicsection` }
+func (cmd Publicsection) Generate(tag string, out emitter.Emitter) {
+	out.Println("%spublicsection", tag)
+}
+
 // Pure is structure for `pure` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdpure
@@ -1128,16 +1407,25 @@ type Raisewarning struct{}
 // Ref is structure for `ref` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdref
-type Ref struct{}
+type Ref struct {
+	Name string `json:"name"`
+	Text string `json:"text"`
+}
+
+func (cmd Ref) Command() string { return `Ref` }
+func (cmd Ref) Generate(tag string, out emitter.Emitter) {
+	out.Print("%sref %s%s", tag, word(cmd.Name), optionalf(` "%s"`, cmd.Text))
+}
 
 // Refitem is structure for `refitem` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdrefitem
 type Refitem struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Refitem) Command() string { return `Refitem` }
+func (cmd Refitem) Key() string     { return cmd.Name }
 func (cmd Refitem) Generate(tag string, out emitter.Emitter) {
 	out.Println("%srefitem %s", tag, word(cmd.Name))
 }
@@ -1146,7 +1434,7 @@ func (cmd Refitem) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdrelated
 type Related struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Related) Command() string { return `Related` }
@@ -1158,7 +1446,7 @@ func (cmd Related) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdrelates
 type Relates struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Relates) Command() string { return `Relates` }
@@ -1170,7 +1458,7 @@ func (cmd Relates) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdrelatedalso
 type Relatedalso struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Relatedalso) Command() string { return `Relatedalso` }
@@ -1182,7 +1470,7 @@ func (cmd Relatedalso) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdrelatesalso
 type Relatesalso struct {
-	Name string
+	Name string `json:"name"`
 }
 
 func (cmd Relatesalso) Command() string { return `Relatesalso` }
@@ -1194,7 +1482,7 @@ func (cmd Relatesalso) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdremark
 type Remark struct {
-	Text string
+	Text string `json:"text"`
 }
 
 func (cmd Remark) Command() string { return `Remark` }
@@ -1206,7 +1494,7 @@ func (cmd Remark) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdremarks
 type Remarks struct {
-	Text string
+	Text string `json:"text"`
 }
 
 func (cmd Remarks) Command() string { return `Remarks` }
@@ -1217,13 +1505,20 @@ func (cmd Remarks) Generate(tag string, out emitter.Emitter) {
 // Result is structure for `result` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdresult
-type Result struct{}
+type Result struct {
+	Description string `json:"description"`
+}
+
+func (cmd Result) Command() string { return `Result` }
+func (cmd Result) Generate(tag string, out emitter.Emitter) {
+	describe(tag, out, tag+"result", cmd.Description)
+}
 
 // Return is structure for `return` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdreturn
 type Return struct {
-	Description string
+	Description string `json:"description"`
 }
 
 func (cmd Return) Command() string { return `Return` }
@@ -1235,7 +1530,7 @@ func (cmd Return) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdreturns
 type Returns struct {
-	Description string
+	Description string `json:"description"`
 }
 
 func (cmd Returns) Command() string { return `Returns` }
@@ -1247,8 +1542,14 @@ func (cmd Returns) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdretval
 type Retval struct {
-	Name    string
-	Message string
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+func (cmd Retval) Command() string { return `Retval` }
+func (cmd Retval) Key() string     { return cmd.Name }
+func (cmd Retval) Generate(tag string, out emitter.Emitter) {
+	describe(tag, out, fmt.Sprintf("%sretval %s", tag, word(cmd.Name)), cmd.Message)
 }
 
 // Rtfinclude is structure for `rtfinclude` command.
@@ -1264,28 +1565,70 @@ type Rtfonly struct{}
 // Sa is structure for `sa` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdsa
-type Sa struct{}
+type Sa struct {
+	References []string `json:"references"`
+}
+
+func (cmd Sa) Command() string { return `Sa` }
+func (cmd Sa) Generate(tag string, out emitter.Emitter) {
+	out.Println("%ssa %s", tag, strings.Join(cmd.References, ", "))
+}
 
 // Secreflist is structure for `secreflist` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdsecreflist
-type Secreflist struct{}
+type Secreflist struct {
+	Items []string `json:"items"`
+}
+
+// Add returns copy of the list extended with references to given sections.
+func (cmd Secreflist) Add(names ...string) Secreflist {
+	items := make([]string, 0, len(cmd.Items)+len(names))
+	cmd.Items = append(append(items, cmd.Items...), names...)
+	return cmd
+}
+
+func (cmd Secreflist) Command() string { return `Secreflist` }
+func (cmd Secreflist) Generate(tag string, out emitter.Emitter) {
+	out.Println("%ssecreflist", tag)
+	defer Endsecreflist{}.Generate(tag, out)
+
+	for _, name := range cmd.Items {
+		Refitem{Name: name}.Generate(tag, out)
+	}
+}
 
 // Section is structure for `section` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdsection
-type Section struct{}
+type Section struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+func (cmd Section) Command() string { return `Section` }
+func (cmd Section) Key() string     { return cmd.Name }
+func (cmd Section) Generate(tag string, out emitter.Emitter) {
+	out.Println("%ssection %s%s", tag, word(cmd.Name), optional(cmd.Title))
+}
 
 // See is structure for `see` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdsee
-type See struct{}
+type See struct {
+	References []string `json:"references"`
+}
+
+func (cmd See) Command() string { return `See` }
+func (cmd See) Generate(tag string, out emitter.Emitter) {
+	out.Println("%ssee %s", tag, strings.Join(cmd.References, ", "))
+}
 
 // Short is structure for `short` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdshort
 type Short struct {
-	ShortDescription string
+	ShortDescription string `json:"shortDescription"`
 }
 
 func (cmd Short) Command() string { return `Short` }
@@ -1297,8 +1640,8 @@ func (cmd Short) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdshowdate
 type Showdate struct {
-	Format   string
-	DateTime string
+	Format   string `json:"format"`
+	DateTime string `json:"dateTime"`
 }
 
 func (cmd Showdate) Command() string { return `Showdate` }
@@ -1324,7 +1667,15 @@ type Showrefs struct{}
 // Since is structure for `since` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdsince
-type Since struct{}
+type Since struct {
+	Version string `json:"version"`
+	Text    string `json:"text"`
+}
+
+func (cmd Since) Command() string { return `Since` }
+func (cmd Since) Generate(tag string, out emitter.Emitter) {
+	describe(tag, out, fmt.Sprintf("%ssince %s", tag, cmd.Version), cmd.Text)
+}
 
 // Skip is structure for `skip` command.
 //
@@ -1364,22 +1715,61 @@ type Startuml struct{}
 // Struct is structure for `struct` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdstruct
-type Struct struct{}
+type Struct struct {
+	Name       string `json:"name"`
+	HeaderFile string `json:"headerFile"`
+	HeaderName string `json:"headerName"`
+}
+
+func (cmd Struct) Command() string { return `Struct` }
+func (cmd Struct) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sstruct %s%s%s", tag,
+		identifier(cmd.Name),
+		optional(word(cmd.HeaderFile)),
+		optional(word(cmd.HeaderName)))
+}
 
 // Subpage is structure for `subpage` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdsubpage
-type Subpage struct{}
+type Subpage struct {
+	Name string `json:"name"`
+	Text string `json:"text"`
+}
+
+func (cmd Subpage) Command() string { return `Subpage` }
+func (cmd Subpage) Key() string     { return cmd.Name }
+func (cmd Subpage) Generate(tag string, out emitter.Emitter) {
+	out.Println("%ssubpage %s%s", tag, word(cmd.Name), optionalf(` "%s"`, cmd.Text))
+}
 
 // Subsection is structure for `subsection` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdsubsection
-type Subsection struct{}
+type Subsection struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+func (cmd Subsection) Command() string { return `Subsection` }
+func (cmd Subsection) Key() string     { return cmd.Name }
+func (cmd Subsection) Generate(tag string, out emitter.Emitter) {
+	out.Println("%ssubsection %s%s", tag, word(cmd.Name), optional(cmd.Title))
+}
 
 // Subsubsection is structure for `subsubsection` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdsubsubsection
-type Subsubsection struct{}
+type Subsubsection struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+func (cmd Subsubsection) Command() string { return `Subsubsection` }
+func (cmd Subsubsection) Key() string     { return cmd.Name }
+func (cmd Subsubsection) Generate(tag string, out emitter.Emitter) {
+	out.Println("%ssubsubsection %s%s", tag, word(cmd.Name), optional(cmd.Title))
+}
 
 // Tableofcontents is structure for `tableofcontents` command.
 //
@@ -1389,17 +1779,25 @@ type Tableofcontents struct{}
 // Test is structure for `test` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdtest
-type Test struct{}
+type Test struct {
+	Paragraph string `json:"paragraph"`
+}
+
+func (cmd Test) Command() string { return `Test` }
+func (cmd Test) Generate(tag string, out emitter.Emitter) {
+	describe(tag, out, tag+"test", cmd.Paragraph)
+}
 
 // Throw is structure for `throw` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdthrow
 type Throw struct {
-	ExceptionObject      string
-	ExceptionDescription string
+	ExceptionObject      string `json:"exceptionObject"`
+	ExceptionDescription string `json:"exceptionDescription"`
 }
 
 func (cmd Throw) Command() string { return `Throw` }
+func (cmd Throw) Key() string     { return cmd.ExceptionObject }
 func (cmd Throw) Generate(tag string, out emitter.Emitter) {
 	out.Print("%sthrow %s %s", tag, word(cmd.ExceptionObject), cmd.ExceptionDescription)
 }
@@ -1408,20 +1806,21 @@ func (cmd Throw) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdthrows
 type Throws struct {
-	ExceptionObject      string
-	ExceptionDescription string
+	ExceptionObject      string `json:"exceptionObject"`
+	ExceptionDescription string `json:"exceptionDescription"`
 }
 
 func (cmd Throws) Command() string { return `Throws` }
+func (cmd Throws) Key() string     { return cmd.ExceptionObject }
 func (cmd Throws) Generate(tag string, out emitter.Emitter) {
-	out.Println("%sthrows", tag, word(cmd.ExceptionObject), cmd.ExceptionDescription)
+	out.Println("%sthrows %s %s", tag, word(cmd.ExceptionObject), cmd.ExceptionDescription)
 }
 
 // Todo is structure for `todo` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdtodo
 type Todo struct {
-	Description string
+	Description string `json:"description"`
 }
 
 func (cmd Todo) Command() string { return `Todo` }
@@ -1432,17 +1831,48 @@ func (cmd Todo) Generate(tag string, out emitter.Emitter) {
 // Tparam is structure for `tparam` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdtparam
-type Tparam struct{}
+type Tparam struct {
+	TemplateParameterName        string `json:"templateParameterName"`
+	TemplateParameterDescription string `json:"templateParameterDescription"`
+}
+
+func (cmd Tparam) Command() string { return `Tparam` }
+func (cmd Tparam) Key() string     { return cmd.TemplateParameterName }
+func (cmd Tparam) Generate(tag string, out emitter.Emitter) {
+	describe(tag, out,
+		fmt.Sprintf("%stparam %s", tag, word(cmd.TemplateParameterName)),
+		cmd.TemplateParameterDescription)
+}
 
 // Typedef is structure for `typedef` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdtypedef
-type Typedef struct{}
+type Typedef struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+func (cmd Typedef) Command() string { return `Typedef` }
+func (cmd Typedef) Generate(tag string, out emitter.Emitter) {
+	out.Println("%stypedef %s %s", tag, cmd.Type, identifier(cmd.Name))
+}
 
 // Union is structure for `union` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdunion
-type Union struct{}
+type Union struct {
+	Name       string `json:"name"`
+	HeaderFile string `json:"headerFile"`
+	HeaderName string `json:"headerName"`
+}
+
+func (cmd Union) Command() string { return `Union` }
+func (cmd Union) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sunion %s%s%s", tag,
+		identifier(cmd.Name),
+		optional(word(cmd.HeaderFile)),
+		optional(word(cmd.HeaderName)))
+}
 
 // Until is structure for `until` command.
 //
@@ -1453,21 +1883,37 @@ type Until struct{}
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdvar
 type Var struct {
-	Datatype    string
-	Name        string
-	Description string
+	Datatype    string `json:"datatype"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
 }
 
 func (cmd Var) Command() string { return `Var` }
 func (cmd Var) Generate(tag string, out emitter.Emitter) {
-	out.Println("%svar %s $%s", tag, cmd.Datatype, cmd.Name)
-	out.Println("%s", cmd.Description)
+	out.Println("%svar %s%s", tag, optionalf("%s ", cmd.Datatype), identifier(cmd.Name))
+	if cmd.Description != "" {
+		lines(out, cmd.Description)
+	}
 }
 
 // Verbatim is structure for `verbatim` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdverbatim
-type Verbatim struct{}
+type Verbatim struct {
+	Text string `json:"text"`
+	// Lines are used instead of Text when set.
+	Lines []string `json:"lines"`
+}
+
+func (cmd Verbatim) Command() string { return `Verbatim` }
+func (cmd Verbatim) Generate(tag string, out emitter.Emitter) {
+	body := block(cmd.Text, cmd.Lines)
+	guard(cmd.Command(), body, "endverbatim")
+
+	out.Println("%sverbatim", tag)
+	emitter.PrintRaw(out, body)
+	Endverbatim{}.Generate(tag, out)
+}
 
 // Verbinclude is structure for `verbinclude` command.
 //
@@ -1478,7 +1924,7 @@ type Verbinclude struct{}
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdversion
 type Version struct {
-	Number string
+	Number string `json:"number"`
 }
 
 func (cmd Version) Command() string { return `Version` }
@@ -1495,7 +1941,7 @@ type Vhdlflow struct{}
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdwarning
 type Warning struct {
-	Message string
+	Message string `json:"message"`
 }
 
 func (cmd Warning) Command() string { return `Warning` }
@@ -1506,7 +1952,15 @@ func (cmd Warning) Generate(tag string, out emitter.Emitter) {
 // Weakgroup is structure for `weakgroup` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdweakgroup
-type Weakgroup struct{}
+type Weakgroup struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+func (cmd Weakgroup) Command() string { return `Weakgroup` }
+func (cmd Weakgroup) Generate(tag string, out emitter.Emitter) {
+	out.Println("%sweakgroup %s%s", tag, word(cmd.Name), optional(cmd.Title))
+}
 
 // Xmlinclude is structure for `xmlinclude` command.
 //
@@ -1521,7 +1975,29 @@ type Xmlonly struct{}
 // Xrefitem is structure for `xrefitem` command.
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdxrefitem
-type Xrefitem struct{}
+type Xrefitem struct {
+	Name      string `json:"name"`
+	Heading   string `json:"heading"`
+	ListTitle string `json:"listTitle"`
+	Text      string `json:"text"`
+}
+
+// Alias returns definition of the custom command, which adds its single
+// argument to the list.
+func (cmd Xrefitem) Alias(name string) AliasDef {
+	return AliasDef{
+		Name:      name,
+		Params:    1,
+		Expansion: fmt.Sprintf(`\xrefitem %s "%s" "%s" \1`, word(cmd.Name), cmd.Heading, cmd.ListTitle),
+	}
+}
+
+func (cmd Xrefitem) Command() string { return `Xrefitem` }
+func (cmd Xrefitem) Key() string     { return cmd.Name + " " + cmd.Text }
+func (cmd Xrefitem) Generate(tag string, out emitter.Emitter) {
+	head := fmt.Sprintf(`%sxrefitem %s "%s" "%s"`, tag, word(cmd.Name), cmd.Heading, cmd.ListTitle)
+	describe(tag, out, head, cmd.Text)
+}
 
 // Dollar is structure for `$` command.
 //
@@ -1567,7 +2043,7 @@ func (cmd Ampersand) Generate(tag string, out emitter.Emitter) {
 //
 // For more details, see: https://doxygen.nl/manual/commands.html#cmdtilde
 type Tilde struct {
-	LanguageID string
+	LanguageID string `json:"languageID"`
 }
 
 func (cmd Tilde) Command() string { return `Tilde` }
@@ -0,0 +1,268 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygentest"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+func TestDescriptionCommands(t *testing.T) {
+	for _, tc := range []struct {
+		cmd  command.Command
+		want string
+	}{
+		{command.Tparam{TemplateParameterName: "T", TemplateParameterDescription: "Element type."}, "\\tparam T Element type.\n"},
+		{command.Since{Version: "1.2", Text: "Added flags."}, "\\since 1.2 Added flags.\n"},
+		{command.Sa{References: []string{"open", "close"}}, "\\sa open, close\n"},
+		{command.Note{Text: "First line.\nSecond line."}, "\\note First line.\nSecond line.\n"},
+		{command.Retval{Name: "0", Message: "Success.\n\nNothing was changed."},
+			"\\retval 0\n\\parblock\nSuccess.\n\nNothing was changed.\n\\endparblock\n"},
+	} {
+		doxygentest.AssertRenders(t, tc.cmd, `\`, tc.want)
+	}
+}
+
+func TestStructuralCommands(t *testing.T) {
+	for _, tc := range []struct {
+		cmd  command.Command
+		want string
+	}{
+		{command.Fn{ReturnType: "int", Name: "open", Parameters: []string{"const char *path", "int flags"}}, "\\fn int open(const char *path, int flags)\n"},
+		{command.Fn{Name: "ns::Widget::operator==", Parameters: []string{"const Widget &other"}, Qualifiers: "const"}, "\\fn ns::Widget::operator==(const Widget &other) const\n"},
+		{command.Var{Datatype: "int", Name: "errno"}, "\\var int errno\n"},
+		{command.Typedef{Type: "unsigned long", Name: "size_type"}, "\\typedef unsigned long size_type\n"},
+		{command.Struct{Name: "stat", HeaderFile: "sys/stat.h"}, "\\struct stat sys/stat.h\n"},
+	} {
+		doxygentest.AssertRenders(t, tc.cmd, `\`, tc.want)
+	}
+}
+
+func TestInvalidIdentifier(t *testing.T) {
+	defer func() {
+		if _, ok := recover().(command.ErrInvalidIdentifier); !ok {
+			t.Error("expected invalid identifier panic")
+		}
+	}()
+	doxygentest.Render(command.Fn{Name: "not valid"}, `\`)
+}
+
+func TestValidateIdentifier(t *testing.T) {
+	for _, name := range []string{"open", "::ns::Class::~Class", "ns::Vec<T>::size", "Map<K, Vec<V>>::at", "Class::operator()", "operator bool", "Class::operator const char *"} {
+		if err := command.ValidateIdentifier(name); err != nil {
+			t.Error(err)
+		}
+	}
+	for _, name := range []string{"not valid", "ns::", "1st", "Vec<T"} {
+		if err := command.ValidateIdentifier(name); err == nil {
+			t.Errorf("expected '%s' to be invalid", name)
+		}
+	}
+}
+
+func TestAlias(t *testing.T) {
+	reqid := command.AliasDef{Name: "reqid", Params: 2, Expansion: `\xrefitem reqs "Requirement" "Requirements" \1: \2`}
+	if def := reqid.Definition(); def != `reqid{2}=\xrefitem reqs "Requirement" "Requirements" \1: \2` {
+		t.Errorf("unexpected definition: %s", def)
+	}
+
+	if _, err := reqid.Command("REQ-1"); err == nil {
+		t.Error("expected arity error")
+	}
+	cmd, err := reqid.Command("REQ-1", "Files are closed, always {eventually")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doxygentest.AssertRenders(t, cmd, `\`, "\\reqid{REQ-1,Files are closed\\, always \\{eventually}\n")
+
+	if err := (command.AliasDef{Name: "bad", Expansion: `\1`}).Validate(); err == nil {
+		t.Error("expected invalid alias error")
+	}
+}
+
+func TestXrefitem(t *testing.T) {
+	req := command.Xrefitem{Name: "reqs", Heading: "Requirement", ListTitle: "Requirements", Text: "Must not block."}
+	doxygentest.AssertRenders(t, req, `\`, "\\xrefitem reqs \"Requirement\" \"Requirements\" Must not block.\n")
+
+	def := req.Alias("req")
+	if got := def.Definition(); got != `req{1}=\xrefitem reqs "Requirement" "Requirements" \1` {
+		t.Errorf("unexpected definition: %s", got)
+	}
+
+	list := command.Secreflist{}.Add("intro", "usage")
+	doxygentest.AssertRenders(t, list, `\`, "\\secreflist\n\\refitem intro\n\\refitem usage\n\\endsecreflist\n")
+}
+
+func TestImage(t *testing.T) {
+	img := command.Image{Format: command.ImageLatex, File: "arch diagram.png", Caption: "Architecture", Width: "0.5\\textwidth", Inline: true}
+	doxygentest.AssertRenders(t, img, `\`, "\\image{inline} latex \"arch diagram.png\" \"Architecture\" width=0.5\\textwidth\n")
+
+	if imgs := command.Images("a.png", ""); len(imgs) != len(command.ImageFormats) {
+		t.Errorf("expected image per format, got %d", len(imgs))
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "a.png"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	v := command.ImageValidator{ImagePath: []string{dir}}
+	if err := v.Validate(command.Image{Format: command.ImageHTML, File: "a.png", Width: "200"}); err != nil {
+		t.Error(err)
+	}
+	if err := v.Validate(command.Image{Format: command.ImageHTML, File: "b.png"}); !errors.As(err, &command.ErrImageNotFound{}) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if err := v.Validate(command.Image{Format: command.ImageLatex, File: "a.png", Width: "200px"}); !errors.As(err, &command.ErrInvalidImageSize{}) {
+		t.Errorf("expected invalid size, got %v", err)
+	}
+}
+
+func TestFormula(t *testing.T) {
+	for _, tc := range []struct {
+		cmd  command.Formula
+		want string
+	}{
+		{command.Formula{Body: `\sqrt{x}`}, `\f$\sqrt{x}\f$`},
+		{command.Formula{Mode: command.FormulaText, Body: `x^2`}, `\f(x^2\f)`},
+		{command.Formula{Mode: command.FormulaDisplay, Body: "a = b"}, "\\f[\na = b\n\\f]\n"},
+		{command.Formula{Mode: command.FormulaEnvironment, Environment: "align", Body: "a &= b \\\\\nc &= d"}, "\\f{align}{\na &= b \\\\\nc &= d\n\\f}\n"},
+	} {
+		doxygentest.AssertRenders(t, tc.cmd, `\`, tc.want)
+	}
+
+	if err := (command.Formula{Body: `\frac{1}{2`}).Validate(); !errors.As(err, &command.ErrUnbalancedBraces{}) {
+		t.Errorf("expected unbalanced braces, got %v", err)
+	}
+	if err := (command.Formula{Mode: command.FormulaDisplay, Body: `x \f] y`}).Validate(); !errors.As(err, &command.ErrFormulaTerminator{}) {
+		t.Errorf("expected terminator error, got %v", err)
+	}
+	if err := (command.Formula{Mode: command.FormulaEnvironment, Body: "a = b"}).Validate(); !errors.As(err, &command.ErrMissingEnvironment{}) {
+		t.Errorf("expected missing environment, got %v", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	nested := command.List{Ordered: true, Items: []command.ListItem{command.Item("first"), command.Item("second")}}
+	items := []command.ListItem{
+		{Content: command.RichText{command.Text{Text: "use "}, command.C{Word: "open()"}}},
+		command.Item("then").With(nested),
+	}
+
+	for _, tc := range []struct {
+		style string
+		want  string
+	}{
+		{command.ListCommands, "\\li use \\c open()\n\\li then\n\t-# first\n\t-# second\n"},
+		{command.ListMarkdown, "- use \\c open()\n- then\n\t1. first\n\t2. second\n"},
+		{command.ListHTML, "<ul>\n\t<li>use \\c open()</li>\n\t<li>then\n\t\t<ol>\n\t\t\t<li>first</li>\n\t\t\t<li>second</li>\n\t\t</ol>\n\t</li>\n</ul>\n"},
+	} {
+		doxygentest.AssertRenders(t, command.List{Style: tc.style, Items: items}, `\`, tc.want)
+	}
+}
+
+func TestTable(t *testing.T) {
+	table := command.NewTable("Code", "Meaning").
+		Align(0, command.AlignRight).
+		TextRow("0", "success").
+		Row(command.TextCell("1"), command.Cell{Content: command.RichText{command.Text{Text: "a|b or "}, command.C{Word: "EINVAL"}}})
+	doxygentest.AssertRenders(t, table, `\`, ""+
+		"| Code | Meaning           |\n"+
+		"| ---: | ----------------- |\n"+
+		"|    0 | success           |\n"+
+		"|    1 | a\\|b or \\c EINVAL |\n")
+
+	spanned := command.NewTable("A", "B").Row(command.Cell{Content: command.Plain("both"), Colspan: 2})
+	doxygentest.AssertRenders(t, spanned, `\`, ""+
+		"<table>\n"+
+		"\t<tr>\n\t\t<th>A</th>\n\t\t<th>B</th>\n\t</tr>\n"+
+		"\t<tr>\n\t\t<td colspan=\"2\">both</td>\n\t</tr>\n"+
+		"</table>\n")
+}
+
+func TestInlineMode(t *testing.T) {
+	text := command.RichText{
+		command.B{Word: "bold"}, command.Text{Text: " "},
+		command.C{Word: "a`b"}, command.Text{Text: " "},
+		command.Em{Word: "snake_case"}, command.Text{Text: " "},
+		command.MultiB{Text: "two words"},
+	}
+	for _, tc := range []struct {
+		mode string
+		want string
+	}{
+		{command.InlineDefault, "\\b bold \\c a`b \\em snake_case <b>two words</b>"},
+		{command.InlineHTML, "<b>bold</b> <tt>a`b</tt> <em>snake_case</em> <b>two words</b>"},
+		{command.InlineMarkdown, "**bold** `` a`b `` *snake\\_case* **two words**"},
+	} {
+		e := emitter.NewEmitter(0)
+		text.Generate(`\`, emitter.WithInlineMode(e, tc.mode))
+		if got := e.String(); got != tc.want {
+			t.Errorf("mode %q: got %q, want %q", tc.mode, got, tc.want)
+		}
+	}
+}
+
+func TestCodeBlocks(t *testing.T) {
+	code := command.Code{Word: "py", Lines: []string{"def f():", "    return 1"}}
+	doxygentest.AssertRenders(t, code, `\`, "\\code{.py}\ndef f():\n    return 1\n\\endcode\n")
+
+	out := emitter.NewEmitter(0)
+	out.Indent(1)
+	command.Verbatim{Text: "\tkeep\n  this"}.Generate(`\`, out)
+	if want := "\t\\verbatim\n\tkeep\n  this\n\t\\endverbatim\n"; out.String() != want {
+		t.Errorf("got %q, want %q", out.String(), want)
+	}
+
+	defer func() {
+		if err, ok := recover().(command.ErrBlockTerminator); !ok || err.Terminator != "*/" {
+			t.Errorf("expected terminator panic, got %v", err)
+		}
+	}()
+	command.Code{CodeBlock: "x = 1; /* y */"}.Generate(`\`, emitter.NewEmitter(0))
+}
+
+func TestRegisteredFieldsHaveJSONTags(t *testing.T) {
+	for _, name := range command.Registered() {
+		cmd, err := command.Lookup(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		typ := reflect.TypeOf(cmd)
+		for i := 0; i < typ.NumField(); i++ {
+			if f := typ.Field(i); f.IsExported() && f.Tag.Get("json") == "" {
+				t.Errorf("field %s of command '%s' has no json tag", f.Name, name)
+			}
+		}
+	}
+}
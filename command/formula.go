@@ -0,0 +1,170 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+// Modes of the formula.
+const (
+	// FormulaInline is formula within text, delimited by `\f$`.
+	FormulaInline = "inline"
+	// FormulaText is formula in LaTeX text mode, delimited by `\f(` and `\f)`.
+	FormulaText = "text"
+	// FormulaDisplay is centered formula on a separate line, delimited by
+	// `\f[` and `\f]`.
+	FormulaDisplay = "display"
+	// FormulaEnvironment is formula in the LaTeX environment, delimited by
+	// `\f{environment}{` and `\f}`.
+	FormulaEnvironment = "environment"
+)
+
+type ErrInvalidFormulaMode struct {
+	Mode string
+}
+
+func (err ErrInvalidFormulaMode) Error() string {
+	return fmt.Sprintf("invalid formula mode '%s'", err.Mode)
+}
+
+type ErrMissingEnvironment struct {
+	Formula string
+}
+
+func (err ErrMissingEnvironment) Error() string {
+	return fmt.Sprintf("missing environment of formula '%s'", err.Formula)
+}
+
+type ErrUnbalancedBraces struct {
+	Formula string
+}
+
+func (err ErrUnbalancedBraces) Error() string {
+	return fmt.Sprintf("unbalanced braces in formula '%s'", err.Formula)
+}
+
+type ErrFormulaTerminator struct {
+	Formula    string
+	Terminator string
+}
+
+func (err ErrFormulaTerminator) Error() string {
+	return fmt.Sprintf("formula '%s' contains its terminator '%s'", err.Formula, err.Terminator)
+}
+
+// Formula is structure for the formula commands, emitting the body between
+// delimiters matching the mode. Empty mode is the same as FormulaInline.
+//
+// For more details, see: https://doxygen.nl/manual/formulas.html
+type Formula struct {
+	Mode        string `json:"mode"`
+	Environment string `json:"environment"`
+	Body        string `json:"body"`
+}
+
+func (cmd Formula) Command() string { return `Formula` }
+func (cmd Formula) Generate(tag string, out emitter.Emitter) {
+	if err := cmd.Validate(); err != nil {
+		panic(err)
+	}
+
+	switch cmd.mode() {
+	case FormulaInline:
+		FDollar{}.Generate(tag, out)
+		out.Print("%s", cmd.Body)
+		FDollar{}.Generate(tag, out)
+	case FormulaText:
+		FParanthesesLeft{}.Generate(tag, out)
+		out.Print("%s", cmd.Body)
+		FParanthesesRight{}.Generate(tag, out)
+	case FormulaDisplay:
+		FBracketLeft{}.Generate(tag, out)
+		lines(out, strings.Trim(cmd.Body, "\n"))
+		FBracketRight{}.Generate(tag, out)
+	case FormulaEnvironment:
+		FBracesLeft{Environment: cmd.Environment}.Generate(tag, out)
+		lines(out, strings.Trim(cmd.Body, "\n"))
+		FBracesRigt{}.Generate(tag, out)
+	}
+}
+
+// Validate checks the mode and environment, that braces in the body are
+// balanced and that the body does not contain the closing delimiter.
+func (cmd Formula) Validate() error {
+	terminator := ""
+	switch cmd.mode() {
+	case FormulaInline:
+		terminator = `\f$`
+	case FormulaText:
+		terminator = `\f)`
+	case FormulaDisplay:
+		terminator = `\f]`
+	case FormulaEnvironment:
+		if cmd.Environment == "" {
+			return ErrMissingEnvironment{Formula: cmd.Body}
+		}
+		terminator = `\f}`
+	default:
+		return ErrInvalidFormulaMode{Mode: cmd.Mode}
+	}
+
+	for _, t := range []string{terminator, "@" + terminator[1:]} {
+		if strings.Contains(cmd.Body, t) {
+			return ErrFormulaTerminator{Formula: cmd.Body, Terminator: t}
+		}
+	}
+
+	depth := 0
+	for i := 0; i < len(cmd.Body); i++ {
+		switch cmd.Body[i] {
+		case '\\':
+			i++
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth < 0 {
+				return ErrUnbalancedBraces{Formula: cmd.Body}
+			}
+		}
+	}
+	if depth != 0 {
+		return ErrUnbalancedBraces{Formula: cmd.Body}
+	}
+	return nil
+}
+
+func (cmd Formula) mode() string {
+	if cmd.Mode == "" {
+		return FormulaInline
+	}
+	return cmd.Mode
+}
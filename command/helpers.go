@@ -28,7 +28,10 @@ package command
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/emitter"
 )
 
 type ErrMustBeSingleWord struct {
@@ -39,6 +42,50 @@ func (err ErrMustBeSingleWord) Error() string {
 	return fmt.Sprintf("'%s' is not a single word", err.Word)
 }
 
+type ErrBlockTerminator struct {
+	Command    string
+	Terminator string
+}
+
+func (err ErrBlockTerminator) Error() string {
+	return fmt.Sprintf("body of '%s' contains '%s'", err.Command, err.Terminator)
+}
+
+type ErrInvalidIdentifier struct {
+	Name string
+}
+
+func (err ErrInvalidIdentifier) Error() string {
+	return fmt.Sprintf("'%s' is not a valid identifier or qualified name", err.Name)
+}
+
+var identifierRegexp = func() *regexp.Regexp {
+	args := `(?:<(?:[^<>]|<[^<>]*>)*>)?`
+	ident := `~?[A-Za-z_][A-Za-z0-9_]*` + args
+	conversion := `(?:const\s+)?(?:::)?(?:[A-Za-z_][A-Za-z0-9_]*` + args + `::)*[A-Za-z_][A-Za-z0-9_]*` + args + `(?:\s*[*&]+)?`
+	op := `operator\s*(?:[-+*/%^&|~!=<>,]+|\(\)|\[\]|(?:new|delete)(?:\[\])?|""\s*[A-Za-z_][A-Za-z0-9_]*)|operator\s+` + conversion
+	return regexp.MustCompile(`^(?:::)?(?:` + ident + `::)*(?:` + op + `|` + ident + `)$`)
+}()
+
+// ValidateIdentifier checks if the name is a C/C++ identifier or a qualified
+// name, such as `ns::Class::method`, `ns::Vec<T>::size`, `Class::operator==`
+// or `Class::operator bool`.
+func ValidateIdentifier(name string) error {
+	if !identifierRegexp.MatchString(name) {
+		return ErrInvalidIdentifier{Name: name}
+	}
+	return nil
+}
+
+// identifier panics if the argument is not valid identifier or qualified
+// name, see ValidateIdentifier.
+func identifier(argument string) string {
+	if err := ValidateIdentifier(argument); err != nil {
+		panic(err)
+	}
+	return argument
+}
+
 func word(argument string) string {
 	if strings.Contains(argument, " ") {
 		panic(ErrMustBeSingleWord{Word: argument})
@@ -59,3 +106,57 @@ func optionalf(format, argument string) string {
 	}
 	return ""
 }
+
+// lines prints every line of text separately, so that all of them get
+// indented by the emitter. Empty lines are printed without indentation.
+func lines(out emitter.Emitter, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		if line == "" {
+			out.Newline()
+			continue
+		}
+		out.Println("%s", line)
+	}
+}
+
+// describe prints head of the command followed by its description. Multi-line
+// description is continued on the following lines, and description consisting
+// of many paragraphs is wrapped in parblock, so that all of them belong to the
+// command.
+func describe(tag string, out emitter.Emitter, head, description string) {
+	description = strings.TrimRight(description, "\n")
+	if strings.Contains(description, "\n\n") {
+		out.Println("%s", head)
+		Parblock{Paragraphs: strings.Split(description, "\n\n")}.Generate(tag, out)
+		return
+	}
+
+	first, rest, multiline := strings.Cut(description, "\n")
+	out.Println("%s%s", head, optional(first))
+	if multiline {
+		lines(out, rest)
+	}
+}
+
+// block returns body of the block command given either as text or as lines.
+// The body always ends with newline, so that the closing command is printed
+// on its own line.
+func block(text string, lines []string) string {
+	if lines != nil {
+		text = strings.Join(lines, "\n")
+	}
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	return text
+}
+
+// guard panics if the body contains the command closing the block or the end
+// of the comment.
+func guard(command, body, end string) {
+	for _, terminator := range []string{`\` + end, "@" + end, "*/"} {
+		if strings.Contains(body, terminator) {
+			panic(ErrBlockTerminator{Command: command, Terminator: terminator})
+		}
+	}
+}
@@ -0,0 +1,116 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// Output formats accepted by the image command.
+const (
+	ImageHTML    = "html"
+	ImageLatex   = "latex"
+	ImageDocbook = "docbook"
+	ImageRTF     = "rtf"
+)
+
+// ImageFormats lists all output formats accepted by the image command.
+var ImageFormats = []string{ImageHTML, ImageLatex, ImageDocbook, ImageRTF}
+
+type ErrInvalidImageFormat struct {
+	Format string
+}
+
+func (err ErrInvalidImageFormat) Error() string {
+	return fmt.Sprintf("invalid image format '%s'", err.Format)
+}
+
+type ErrImageNotFound struct {
+	File string
+}
+
+func (err ErrImageNotFound) Error() string {
+	return fmt.Sprintf("image '%s' not found on image path", err.File)
+}
+
+type ErrInvalidImageSize struct {
+	Format string
+	Size   string
+}
+
+func (err ErrInvalidImageSize) Error() string {
+	return fmt.Sprintf("invalid %s image size '%s'", err.Format, err.Size)
+}
+
+var latexSizeRegexp = regexp.MustCompile(
+	`^([0-9]*\.?[0-9]+)\s*(pt|mm|cm|in|ex|em|bp|pc|dd|cc|sp|\\(textwidth|linewidth|textheight|columnwidth|paperwidth|paperheight))$`)
+
+func validImageFormat(format string) bool {
+	for _, f := range ImageFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// ImageValidator checks image commands against the IMAGE_PATH configuration.
+type ImageValidator struct {
+	ImagePath []string
+}
+
+// Validate checks that the format is known, the file exists in one of the
+// image path directories and that sizes of LaTeX images use legal units.
+func (v ImageValidator) Validate(img Image) error {
+	if !validImageFormat(img.Format) {
+		return ErrInvalidImageFormat{Format: img.Format}
+	}
+
+	if img.Format == ImageLatex {
+		for _, size := range []string{img.Width, img.Height} {
+			if size != "" && !latexSizeRegexp.MatchString(size) {
+				return ErrInvalidImageSize{Format: img.Format, Size: size}
+			}
+		}
+	}
+
+	if filepath.IsAbs(img.File) {
+		if _, err := os.Stat(img.File); err == nil {
+			return nil
+		}
+		return ErrImageNotFound{File: img.File}
+	}
+	for _, dir := range v.ImagePath {
+		if _, err := os.Stat(filepath.Join(dir, img.File)); err == nil {
+			return nil
+		}
+	}
+	return ErrImageNotFound{File: img.File}
+}
@@ -0,0 +1,123 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+// Inline modes, in which font commands such as B, C or Em are rendered. The
+// mode is taken from the emitter implementing emitter.Styler.
+const (
+	// InlineDefault renders single word commands as commands and multi-word
+	// commands as HTML.
+	InlineDefault = ""
+	// InlineCommands renders commands such as `\b word`. Multi-word text,
+	// which has no command equivalent, is rendered as HTML.
+	InlineCommands = "commands"
+	// InlineHTML renders HTML such as `<b>word</b>`.
+	InlineHTML = "html"
+	// InlineMarkdown renders Markdown such as `**word**`.
+	InlineMarkdown = "markdown"
+)
+
+type ErrInvalidInlineMode struct {
+	Mode string
+}
+
+func (err ErrInvalidInlineMode) Error() string {
+	return fmt.Sprintf("invalid inline mode '%s'", err.Mode)
+}
+
+// htmlElements maps font commands onto HTML elements.
+var htmlElements = map[string]string{
+	"a":  "em",
+	"b":  "b",
+	"c":  "tt",
+	"e":  "em",
+	"em": "em",
+	"p":  "tt",
+}
+
+// InlineModeOf returns inline mode of the emitter.
+func InlineModeOf(out emitter.Emitter) string {
+	if s, ok := out.(emitter.Styler); ok {
+		return s.InlineMode()
+	}
+	return InlineDefault
+}
+
+// inline prints single word with font command.
+func inline(tag string, out emitter.Emitter, name, argument string) {
+	switch mode := InlineModeOf(out); mode {
+	case InlineDefault, InlineCommands:
+		out.Print("%s%s %s", tag, name, word(argument))
+	case InlineHTML:
+		out.Print("<%[1]s>%[2]s</%[1]s>", htmlElements[name], word(argument))
+	case InlineMarkdown:
+		out.Print("%s", markdown(name, word(argument)))
+	default:
+		panic(ErrInvalidInlineMode{Mode: mode})
+	}
+}
+
+// multiInline prints multi-word text with font command.
+func multiInline(out emitter.Emitter, name, text string) {
+	switch mode := InlineModeOf(out); mode {
+	case InlineDefault, InlineCommands, InlineHTML:
+		out.Print("<%[1]s>%[2]s</%[1]s>", htmlElements[name], text)
+	case InlineMarkdown:
+		out.Print("%s", markdown(name, text))
+	default:
+		panic(ErrInvalidInlineMode{Mode: mode})
+	}
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, `*`, `\*`, `_`, `\_`, "`", "\\`", `~`, `\~`, `[`, `\[`, `]`, `\]`, `<`, `\<`,
+)
+
+func markdown(name, text string) string {
+	switch htmlElements[name] {
+	case "tt":
+		fence := "`"
+		for strings.Contains(text, fence) {
+			fence += "`"
+		}
+		if len(fence) > 1 {
+			return fmt.Sprintf("%s %s %s", fence, text, fence)
+		}
+		return fence + text + fence
+	case "b":
+		return "**" + markdownEscaper.Replace(text) + "**"
+	default:
+		return "*" + markdownEscaper.Replace(text) + "*"
+	}
+}
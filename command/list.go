@@ -0,0 +1,141 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command
+
+import (
+	"fmt"
+
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+// Styles of the list.
+const (
+	// ListCommands renders items with `\li` command, and ordered items with
+	// `-#` marker.
+	ListCommands = "commands"
+	// ListMarkdown renders items with `-` and `1.` markers.
+	ListMarkdown = "markdown"
+	// ListHTML renders list with `<ul>` or `<ol>` markup.
+	ListHTML = "html"
+)
+
+type ErrInvalidListStyle struct {
+	Style string
+}
+
+func (err ErrInvalidListStyle) Error() string {
+	return fmt.Sprintf("invalid list style '%s'", err.Style)
+}
+
+// ListItem is single item of the list, optionally followed by nested list.
+type ListItem struct {
+	Content RichText `json:"content"`
+	Sublist *List    `json:"sublist"`
+}
+
+// Item returns list item with plain text content.
+func Item(text string) ListItem {
+	return ListItem{Content: Plain(text)}
+}
+
+// With returns copy of the item with given nested list.
+func (item ListItem) With(sublist List) ListItem {
+	item.Sublist = &sublist
+	return item
+}
+
+// List is structure for ordered and unordered lists. Nested items and
+// continuation lines are indented by the emitter, so the list stays aligned
+// regardless of indentation of the comment. Empty style is the same as
+// ListCommands.
+//
+// For more details, see: https://doxygen.nl/manual/lists.html
+type List struct {
+	Style   string     `json:"style"`
+	Ordered bool       `json:"ordered"`
+	Items   []ListItem `json:"items"`
+}
+
+func (cmd List) Command() string { return `List` }
+func (cmd List) Generate(tag string, out emitter.Emitter) {
+	style := cmd.Style
+	if style == "" {
+		style = ListCommands
+	}
+
+	switch style {
+	case ListCommands, ListMarkdown:
+		cmd.generateItems(tag, out, style)
+	case ListHTML:
+		element := "ul"
+		if cmd.Ordered {
+			element = "ol"
+		}
+		out.Println("<%s>", element)
+		out.Indent(1)
+		cmd.generateItems(tag, out, style)
+		out.Indent(-1)
+		out.Println("</%s>", element)
+	default:
+		panic(ErrInvalidListStyle{Style: cmd.Style})
+	}
+}
+
+func (cmd List) generateItems(tag string, out emitter.Emitter, style string) {
+	for i, item := range cmd.Items {
+		switch {
+		case style == ListHTML:
+			out.Print("<li>")
+		case style == ListMarkdown && cmd.Ordered:
+			out.Print("%d. ", i+1)
+		case style == ListMarkdown:
+			out.Print("- ")
+		case cmd.Ordered:
+			out.Print("-# ")
+		default:
+			out.Print("%sli ", tag)
+		}
+
+		out.Indent(1)
+		item.Content.Generate(tag, out)
+		if item.Sublist != nil {
+			out.Newline()
+			sublist := *item.Sublist
+			if sublist.Style == "" {
+				sublist.Style = style
+			}
+			sublist.Generate(tag, out)
+		}
+		out.Indent(-1)
+
+		if style == ListHTML {
+			out.Println("</li>")
+		} else if item.Sublist == nil {
+			out.Newline()
+		}
+	}
+}
@@ -0,0 +1,171 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type ErrUnknownCommand struct {
+	Name string
+}
+
+func (err ErrUnknownCommand) Error() string {
+	return fmt.Sprintf("unknown command '%s'", err.Name)
+}
+
+var registry = map[string]reflect.Type{}
+
+func init() {
+	for _, cmd := range []Command{
+		A{}, Addindex{}, Addtogroup{}, Alias{}, Ampersand{}, Anchor{}, Arg{},
+		At{}, Attention{}, Author{}, Authors{}, B{}, Backslash{}, Brief{},
+		Bug{}, C{}, Callergraph{}, Callgraph{}, Category{}, CharDot{}, Cite{},
+		Class{}, Code{}, Colon{}, Concept{}, Cond{}, Copybrief{},
+		Copydetails{}, Copydoc{}, Copyright{}, Date{}, Def{}, Defgroup{},
+		Deprecated{}, Details{}, Diafile{}, Dir{}, Dollar{}, E{}, Em{},
+		Emoji{}, Endcode{}, Endlink{}, Endparblock{}, Endsecreflist{},
+		Endverbatim{}, Enum{}, Equals{}, Exception{}, Extends{}, FBracesLeft{},
+		FBracesRigt{}, FBracketLeft{}, FBracketRight{}, FDollar{}, File{},
+		Fn{}, Formula{}, FParanthesesLeft{}, FParanthesesRight{},
+		GreaterThan{}, Hashtag{}, HeaderFile{}, Idlexcept{}, Image{},
+		Implements{}, Ingroup{}, Interface{}, Invariant{}, LessThan{}, Li{},
+		Link{}, List{}, Mainpage{}, MDash{}, Memberof{}, MultiB{}, MultiEm{},
+		N{}, Name{}, Namespace{}, NDash{}, Noop{}, Nosubgrouping{}, Note{},
+		P{}, Package{}, Page{}, Par{}, Paragraph{}, Param{}, Parblock{},
+		Percent{}, Pipe{}, Post{}, Pre{}, Private{}, Privatesection{},
+		Property{}, Protected{}, Protectedsection{}, Protocol{}, Public{},
+		Publicsection{}, QuotationMark{}, Ref{}, Refitem{}, Related{},
+		Relatedalso{}, Relates{}, Relatesalso{}, Remark{}, Remarks{}, Result{},
+		Return{}, Returns{}, Retval{}, Sa{}, Secreflist{}, Section{}, See{},
+		Short{}, Showdate{}, Since{}, Struct{}, Subpage{}, Subsection{},
+		Subsubsection{}, Table{}, Test{}, Text{}, Throw{}, Throws{}, Tilde{},
+		Todo{}, Tparam{}, Typedef{}, Union{}, Var{}, Verbatim{}, Version{},
+		Warning{}, Weakgroup{}, Xrefitem{},
+	} {
+		Register(cmd)
+	}
+}
+
+// NameOf returns name under which the command is registered, e.g. `param` for
+// Param command.
+func NameOf(cmd Command) string {
+	return strings.ToLower(cmd.Command())
+}
+
+// Register makes the command available for decoding. Commands defined outside
+// of this package should be registered before decoding any documents
+// containing them.
+func Register(cmd Command) {
+	registry[NameOf(cmd)] = reflect.TypeOf(cmd)
+}
+
+// Registered returns sorted names of all registered commands.
+func Registered() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns zero value of the command registered under given name.
+func Lookup(name string) (Command, error) {
+	typ, ok := registry[name]
+	if !ok {
+		return nil, ErrUnknownCommand{Name: name}
+	}
+	return reflect.Zero(typ).Interface().(Command), nil
+}
+
+// MarshalJSON encodes the command as JSON object with its registered name
+// stored under the `cmd` key, followed by command fields in declaration order.
+// Fields are named by their `json` tags, so that the format does not depend
+// on names of the Go fields.
+func MarshalJSON(cmd Command) ([]byte, error) {
+	if _, ok := registry[NameOf(cmd)]; !ok {
+		return nil, ErrUnknownCommand{Name: NameOf(cmd)}
+	}
+
+	fields, err := json.Marshal(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) < 2 || fields[0] != '{' {
+		return nil, fmt.Errorf("command '%s' is not encoded as an object", NameOf(cmd))
+	}
+
+	name, _ := json.Marshal(NameOf(cmd))
+	buf := bytes.Buffer{}
+	buf.WriteString(`{"cmd":`)
+	buf.Write(name)
+	if len(fields) > 2 {
+		buf.WriteByte(',')
+	}
+	buf.Write(fields[1:])
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes command encoded by MarshalJSON. Unknown commands and
+// unknown fields are rejected.
+func UnmarshalJSON(data []byte) (Command, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	var name string
+	if raw, ok := fields["cmd"]; !ok {
+		return nil, fmt.Errorf("missing 'cmd' discriminator")
+	} else if err := json.Unmarshal(raw, &name); err != nil {
+		return nil, fmt.Errorf("invalid 'cmd' discriminator: %w", err)
+	}
+	delete(fields, "cmd")
+
+	typ, ok := registry[name]
+	if !ok {
+		return nil, ErrUnknownCommand{Name: name}
+	}
+
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(rest))
+	dec.DisallowUnknownFields()
+	cmd := reflect.New(typ)
+	if err := dec.Decode(cmd.Interface()); err != nil {
+		return nil, fmt.Errorf("command '%s': %w", name, err)
+	}
+	return cmd.Elem().Interface().(Command), nil
+}
@@ -0,0 +1,113 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command
+
+// Symbol kinds returned by Declares.
+const (
+	KindCategory  = "category"
+	KindAnchor    = "anchor"
+	KindClass     = "class"
+	KindConcept   = "concept"
+	KindDefine    = "define"
+	KindDir       = "dir"
+	KindEnum      = "enum"
+	KindFile      = "file"
+	KindFunction  = "function"
+	KindGroup     = "group"
+	KindInterface = "interface"
+	KindNamespace = "namespace"
+	KindPackage   = "package"
+	KindPage      = "page"
+	KindProperty  = "property"
+	KindProtocol  = "protocol"
+	KindSection   = "section"
+	KindStruct    = "struct"
+	KindTypedef   = "typedef"
+	KindUnion     = "union"
+	KindVariable  = "variable"
+)
+
+// Declares returns kind and name of the entity documented by a structural
+// command, such as Class or Fn, or of the reference target defined by
+// commands such as Anchor or Section. It reports false for all other commands.
+func Declares(cmd Command) (kind, name string, ok bool) {
+	switch cmd := cmd.(type) {
+	case Anchor:
+		return KindAnchor, cmd.Name, true
+	case Addtogroup:
+		return KindGroup, cmd.Name, true
+	case Category:
+		return KindCategory, cmd.Name, true
+	case Class:
+		return KindClass, cmd.Name, true
+	case Concept:
+		return KindConcept, cmd.Name, true
+	case Def:
+		return KindDefine, cmd.Name, true
+	case Defgroup:
+		return KindGroup, cmd.Name, true
+	case Dir:
+		return KindDir, cmd.PathFragment, true
+	case Enum:
+		return KindEnum, cmd.Name, true
+	case File:
+		return KindFile, cmd.Name, true
+	case Fn:
+		return KindFunction, cmd.Name, true
+	case Interface:
+		return KindInterface, cmd.Name, true
+	case Namespace:
+		return KindNamespace, cmd.Name, true
+	case Mainpage:
+		return KindPage, "index", true
+	case Package:
+		return KindPackage, cmd.Name, true
+	case Page:
+		return KindPage, cmd.Name, true
+	case Property:
+		return KindProperty, cmd.Name, true
+	case Protocol:
+		return KindProtocol, cmd.Name, true
+	case Section:
+		return KindSection, cmd.Name, true
+	case Subsection:
+		return KindSection, cmd.Name, true
+	case Subsubsection:
+		return KindSection, cmd.Name, true
+	case Struct:
+		return KindStruct, cmd.Name, true
+	case Typedef:
+		return KindTypedef, cmd.Name, true
+	case Union:
+		return KindUnion, cmd.Name, true
+	case Var:
+		return KindVariable, cmd.Name, true
+	case Weakgroup:
+		return KindGroup, cmd.Name, true
+	}
+	return "", "", false
+}
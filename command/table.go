@@ -0,0 +1,287 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+// Styles of the table.
+const (
+	// TableAuto renders Markdown table, unless cells span many columns or
+	// rows, or contain many lines, in which case HTML is used.
+	TableAuto = ""
+	// TableMarkdown renders Doxygen Markdown table.
+	TableMarkdown = "markdown"
+	// TableHTML renders HTML `<table>` markup.
+	TableHTML = "html"
+)
+
+// Alignments of the table column.
+const (
+	AlignDefault = ""
+	AlignLeft    = "left"
+	AlignCenter  = "center"
+	AlignRight   = "right"
+)
+
+type ErrInvalidTableStyle struct {
+	Style string
+}
+
+func (err ErrInvalidTableStyle) Error() string {
+	return fmt.Sprintf("invalid table style '%s'", err.Style)
+}
+
+type ErrInvalidAlignment struct {
+	Align string
+}
+
+func (err ErrInvalidAlignment) Error() string {
+	return fmt.Sprintf("invalid alignment '%s'", err.Align)
+}
+
+// Column is header of the table column.
+type Column struct {
+	Header RichText `json:"header"`
+	Align  string   `json:"align"`
+}
+
+// Cell is single cell of the table. Zero spans are the same as 1.
+type Cell struct {
+	Content RichText `json:"content"`
+	Colspan int      `json:"colspan"`
+	Rowspan int      `json:"rowspan"`
+}
+
+// TextCell returns cell with plain text content.
+func TextCell(text string) Cell {
+	return Cell{Content: Plain(text)}
+}
+
+// Table is structure for Markdown and HTML tables.
+//
+// For more details, see: https://doxygen.nl/manual/tables.html
+type Table struct {
+	Style   string   `json:"style"`
+	Columns []Column `json:"columns"`
+	Rows    [][]Cell `json:"rows"`
+}
+
+// NewTable returns table with given plain text headers.
+func NewTable(headers ...string) Table {
+	t := Table{}
+	for _, header := range headers {
+		t.Columns = append(t.Columns, Column{Header: Plain(header)})
+	}
+	return t
+}
+
+// Align returns copy of the table with alignment of the column changed.
+func (cmd Table) Align(column int, align string) Table {
+	cmd.Columns = append([]Column(nil), cmd.Columns...)
+	cmd.Columns[column].Align = align
+	return cmd
+}
+
+// Row returns copy of the table with row of given cells appended.
+func (cmd Table) Row(cells ...Cell) Table {
+	cmd.Rows = append(append([][]Cell(nil), cmd.Rows...), cells)
+	return cmd
+}
+
+// TextRow returns copy of the table with row of plain text cells appended.
+func (cmd Table) TextRow(cells ...string) Table {
+	row := make([]Cell, 0, len(cells))
+	for _, cell := range cells {
+		row = append(row, TextCell(cell))
+	}
+	return cmd.Row(row...)
+}
+
+func (cmd Table) Command() string { return `Table` }
+func (cmd Table) Generate(tag string, out emitter.Emitter) {
+	for _, col := range cmd.Columns {
+		switch col.Align {
+		case AlignDefault, AlignLeft, AlignCenter, AlignRight:
+		default:
+			panic(ErrInvalidAlignment{Align: col.Align})
+		}
+	}
+
+	switch cmd.Style {
+	case TableAuto:
+		if cmd.needsHTML(tag, out) {
+			cmd.generateHTML(tag, out)
+		} else {
+			cmd.generateMarkdown(tag, out)
+		}
+	case TableMarkdown:
+		cmd.generateMarkdown(tag, out)
+	case TableHTML:
+		cmd.generateHTML(tag, out)
+	default:
+		panic(ErrInvalidTableStyle{Style: cmd.Style})
+	}
+}
+
+func (cmd Table) needsHTML(tag string, out emitter.Emitter) bool {
+	for _, row := range cmd.Rows {
+		for _, cell := range row {
+			if cell.Colspan > 1 || cell.Rowspan > 1 || strings.Contains(render(tag, out, cell.Content), "\n") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (cmd Table) generateMarkdown(tag string, out emitter.Emitter) {
+	cells := [][]string{make([]string, len(cmd.Columns))}
+	for i, col := range cmd.Columns {
+		cells[0][i] = markdownCell(tag, out, col.Header)
+	}
+	for _, row := range cmd.Rows {
+		line := make([]string, len(cmd.Columns))
+		for i := 0; i < len(row) && i < len(line); i++ {
+			line[i] = markdownCell(tag, out, row[i].Content)
+		}
+		cells = append(cells, line)
+	}
+
+	widths := make([]int, len(cmd.Columns))
+	for i := range widths {
+		widths[i] = 3
+		for _, line := range cells {
+			if n := utf8.RuneCountInString(line[i]); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	separator := make([]string, len(cmd.Columns))
+	for i, col := range cmd.Columns {
+		dashes := widths[i]
+		left, right := "", ""
+		if col.Align == AlignLeft || col.Align == AlignCenter {
+			left, dashes = ":", dashes-1
+		}
+		if col.Align == AlignRight || col.Align == AlignCenter {
+			right, dashes = ":", dashes-1
+		}
+		separator[i] = left + strings.Repeat("-", dashes) + right
+	}
+
+	printRow := func(line []string) {
+		padded := make([]string, len(line))
+		for i, cell := range line {
+			pad := widths[i] - utf8.RuneCountInString(cell)
+			switch cmd.Columns[i].Align {
+			case AlignRight:
+				padded[i] = strings.Repeat(" ", pad) + cell
+			case AlignCenter:
+				padded[i] = strings.Repeat(" ", pad/2) + cell + strings.Repeat(" ", pad-pad/2)
+			default:
+				padded[i] = cell + strings.Repeat(" ", pad)
+			}
+		}
+		out.Println("| %s |", strings.Join(padded, " | "))
+	}
+	printRow(cells[0])
+	printRow(separator)
+	for _, line := range cells[1:] {
+		printRow(line)
+	}
+}
+
+func (cmd Table) generateHTML(tag string, out emitter.Emitter) {
+	out.Println("<table>")
+	out.Indent(1)
+
+	out.Println("<tr>")
+	out.Indent(1)
+	for _, col := range cmd.Columns {
+		htmlCell(tag, out, "th", col.Align, Cell{Content: col.Header})
+	}
+	out.Indent(-1)
+	out.Println("</tr>")
+
+	for _, row := range cmd.Rows {
+		out.Println("<tr>")
+		out.Indent(1)
+		column := 0
+		for _, cell := range row {
+			align := ""
+			if column < len(cmd.Columns) {
+				align = cmd.Columns[column].Align
+			}
+			htmlCell(tag, out, "td", align, cell)
+			column++
+			if cell.Colspan > 1 {
+				column += cell.Colspan - 1
+			}
+		}
+		out.Indent(-1)
+		out.Println("</tr>")
+	}
+
+	out.Indent(-1)
+	out.Println("</table>")
+}
+
+func htmlCell(tag string, out emitter.Emitter, element, align string, cell Cell) {
+	out.Print("<%s", element)
+	if cell.Colspan > 1 {
+		out.Print(` colspan="%d"`, cell.Colspan)
+	}
+	if cell.Rowspan > 1 {
+		out.Print(` rowspan="%d"`, cell.Rowspan)
+	}
+	if align != AlignDefault {
+		out.Print(` align="%s"`, align)
+	}
+	out.Print(">")
+	out.Indent(1)
+	cell.Content.Generate(tag, out)
+	out.Indent(-1)
+	out.Println("</%s>", element)
+}
+
+// render returns the text as it would be printed by the emitter.
+func render(tag string, out emitter.Emitter, text RichText) string {
+	e := emitter.NewEmitter(0)
+	text.Generate(tag, emitter.WithInlineMode(e, InlineModeOf(out)))
+	return e.String()
+}
+
+func markdownCell(tag string, out emitter.Emitter, text RichText) string {
+	return strings.ReplaceAll(strings.TrimSpace(render(tag, out, text)), "|", `\|`)
+}
@@ -0,0 +1,98 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package command
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+// Text is plain text printed within the line. Lines of multi-line text are
+// continued with the current indentation.
+type Text struct {
+	Text string `json:"text"`
+}
+
+func (cmd Text) Command() string { return `Text` }
+func (cmd Text) Generate(tag string, out emitter.Emitter) {
+	for i, line := range strings.Split(cmd.Text, "\n") {
+		if i > 0 {
+			out.Newline()
+		}
+		if line != "" {
+			out.Print("%s", line)
+		}
+	}
+}
+
+// RichText is sequence of inline commands, such as Text, B or Ref, forming
+// single piece of text.
+type RichText []Command
+
+// Plain returns rich text consisting of the plain text only.
+func Plain(text string) RichText {
+	return RichText{Text{Text: text}}
+}
+
+// Generate prints all commands one after another, without newline at the end.
+func (rt RichText) Generate(tag string, out emitter.Emitter) {
+	for _, cmd := range rt {
+		cmd.Generate(tag, out)
+	}
+}
+
+// MarshalJSON encodes rich text as array of registered commands.
+func (rt RichText) MarshalJSON() ([]byte, error) {
+	cmds := make([]json.RawMessage, 0, len(rt))
+	for _, cmd := range rt {
+		data, err := MarshalJSON(cmd)
+		if err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, data)
+	}
+	return json.Marshal(cmds)
+}
+
+// UnmarshalJSON decodes rich text encoded by MarshalJSON.
+func (rt *RichText) UnmarshalJSON(data []byte) error {
+	var cmds []json.RawMessage
+	if err := json.Unmarshal(data, &cmds); err != nil {
+		return err
+	}
+	*rt = make(RichText, 0, len(cmds))
+	for _, raw := range cmds {
+		cmd, err := UnmarshalJSON(raw)
+		if err != nil {
+			return err
+		}
+		*rt = append(*rt, cmd)
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxyfile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+// Include is the pseudo key of `@INCLUDE` directives.
+const Include = "@INCLUDE"
+
+type ErrUnknownKey struct {
+	Key string
+}
+
+func (err ErrUnknownKey) Error() string {
+	return fmt.Sprintf("unknown configuration key '%s'", err.Key)
+}
+
+type ErrInvalidValue struct {
+	Key   string
+	Value string
+}
+
+func (err ErrInvalidValue) Error() string {
+	return fmt.Sprintf("invalid value '%s' of configuration key '%s'", err.Value, err.Key)
+}
+
+// Entry is a single assignment, append or include directive.
+type Entry struct {
+	// Comments are comment and blank lines preceding the entry, written back
+	// as they are.
+	Comments []string
+	Key      string
+	Append   bool
+	Values   []string
+	// Includes are configurations read from files listed in Values of
+	// `@INCLUDE` directive.
+	Includes []*Config
+
+	// lines are the lines the entry was read from, written back as they are
+	// unless the entry is modified.
+	lines  []string
+	parsed *Entry
+}
+
+// modified reports whether the entry differs from the one read from lines.
+func (e *Entry) modified() bool {
+	if e.lines == nil || e.Key != e.parsed.Key || e.Append != e.parsed.Append || len(e.Values) != len(e.parsed.Values) {
+		return true
+	}
+	for i := range e.Values {
+		if e.Values[i] != e.parsed.Values[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Config is a Doxyfile. Entries are kept in the order they were read in, so
+// the file can be written back with minimal changes.
+type Config struct {
+	Entries []*Entry
+	// Trailer are comment and blank lines following the last entry.
+	Trailer []string
+
+	// newline is line ending of the file the configuration was read from.
+	newline string
+}
+
+func New() *Config {
+	return &Config{}
+}
+
+// resolve applies all entries in order, including the included files.
+func (c *Config) resolve(values map[string][]string) {
+	for _, e := range c.Entries {
+		switch {
+		case e.Key == Include:
+			for _, inc := range e.Includes {
+				inc.resolve(values)
+			}
+		case e.Append:
+			values[e.Key] = append(values[e.Key], e.Values...)
+		default:
+			values[e.Key] = append([]string{}, e.Values...)
+		}
+	}
+}
+
+// Get returns the value of the key as set by the configuration and the files
+// it includes, without applying defaults.
+func (c *Config) Get(key string) ([]string, bool) {
+	values := map[string][]string{}
+	c.resolve(values)
+	v, ok := values[key]
+	return v, ok
+}
+
+// Keys returns all keys set by the configuration and the files it includes,
+// in the order of first assignment.
+func (c *Config) Keys() []string {
+	var keys []string
+	seen := map[string]bool{}
+	var walk func(c *Config)
+	walk = func(c *Config) {
+		for _, e := range c.Entries {
+			if e.Key == Include {
+				for _, inc := range e.Includes {
+					walk(inc)
+				}
+				continue
+			}
+			if !seen[e.Key] {
+				seen[e.Key] = true
+				keys = append(keys, e.Key)
+			}
+		}
+	}
+	walk(c)
+	return keys
+}
+
+// Value returns the value of the key, or its default if the key is known,
+// but not set.
+func (c *Config) Value(key string) []string {
+	if v, ok := c.Get(key); ok {
+		return v
+	}
+	if opt, ok := known[key]; ok {
+		return opt.Default
+	}
+	return nil
+}
+
+// String returns the value of the key as a single string.
+func (c *Config) String(key string) string {
+	return strings.Join(c.Value(key), " ")
+}
+
+// Bool returns the value of a YES/NO key.
+func (c *Config) Bool(key string) (bool, error) {
+	switch v := strings.ToUpper(c.String(key)); v {
+	case "YES":
+		return true, nil
+	case "NO", "":
+		return false, nil
+	}
+	return false, ErrInvalidValue{Key: key, Value: c.String(key)}
+}
+
+// Int returns the value of an integer key.
+func (c *Config) Int(key string) (int, error) {
+	v := c.String(key)
+	if v == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, ErrInvalidValue{Key: key, Value: v}
+	}
+	return i, nil
+}
+
+// Set sets the value of the key. The first entry of the key is updated in
+// place and the following ones are removed. If there is none, a new entry is
+// added at the end.
+func (c *Config) Set(key string, values ...string) {
+	var entries []*Entry
+	var first *Entry
+	for _, e := range c.Entries {
+		if e.Key != key {
+			entries = append(entries, e)
+			continue
+		}
+		if first == nil {
+			first = e
+			first.Append = false
+			first.Values = values
+			entries = append(entries, e)
+		}
+	}
+	if first == nil {
+		entries = append(entries, &Entry{Key: key, Values: values})
+	}
+	c.Entries = entries
+}
+
+// SetBool sets the value of a YES/NO key.
+func (c *Config) SetBool(key string, value bool) {
+	if value {
+		c.Set(key, "YES")
+	} else {
+		c.Set(key, "NO")
+	}
+}
+
+// SetInt sets the value of an integer key.
+func (c *Config) SetInt(key string, value int) {
+	c.Set(key, strconv.Itoa(value))
+}
+
+// Append adds values to the key, extending its last entry or adding a new
+// `+=` entry at the end.
+func (c *Config) Append(key string, values ...string) {
+	for i := len(c.Entries) - 1; i >= 0; i-- {
+		if c.Entries[i].Key == key {
+			c.Entries[i].Values = append(c.Entries[i].Values, values...)
+			return
+		}
+	}
+	c.Entries = append(c.Entries, &Entry{Key: key, Append: true, Values: values})
+}
+
+// AddAliases appends definitions of the aliases to the ALIASES option.
+func (c *Config) AddAliases(defs ...command.AliasDef) {
+	c.Append("ALIASES", command.AliasDefinitions(defs...)...)
+}
+
+// ImageValidator returns validator of image commands using IMAGE_PATH.
+func (c *Config) ImageValidator() command.ImageValidator {
+	return command.ImageValidator{ImagePath: c.Value("IMAGE_PATH")}
+}
+
+// Unset removes all entries of the key, making it fall back to the included
+// files or the default value.
+func (c *Config) Unset(key string) {
+	var entries []*Entry
+	for _, e := range c.Entries {
+		if e.Key != key {
+			entries = append(entries, e)
+		}
+	}
+	c.Entries = entries
+}
+
+// Validate checks that all keys are known and their values match their types.
+func (c *Config) Validate() error {
+	var errs doxygen.Errors
+	for _, key := range c.Keys() {
+		if key == "@INCLUDE_PATH" {
+			continue
+		}
+		opt, ok := known[key]
+		if !ok {
+			errs = append(errs, ErrUnknownKey{Key: key})
+			continue
+		}
+
+		v := c.String(key)
+		switch opt.Type {
+		case Bool:
+			if _, err := c.Bool(key); err != nil {
+				errs = append(errs, err)
+			}
+		case Int:
+			if _, err := c.Int(key); err != nil {
+				errs = append(errs, err)
+			}
+		case Enum:
+			valid := false
+			for _, allowed := range opt.Values {
+				valid = valid || strings.EqualFold(v, allowed)
+			}
+			if !valid {
+				errs = append(errs, ErrInvalidValue{Key: key, Value: v})
+			}
+		}
+	}
+	return errs.Err()
+}
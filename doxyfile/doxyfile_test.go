@@ -0,0 +1,175 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxyfile_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/doxyfile"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+const sample = `# Project settings
+@INCLUDE               = base.cfg
+PROJECT_NAME           = "My \"Best\" Project"
+FILE_PATTERNS         += *.hpp \
+                         *.hh
+INPUT                  = include
+
+# Output
+GENERATE_LATEX         = NO
+# end
+`
+
+func TestParse(t *testing.T) {
+	c, err := doxyfile.Parse(strings.NewReader(sample), "testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := c.String("PROJECT_NAME"); got != `My "Best" Project` {
+		t.Errorf("unexpected project name: %s", got)
+	}
+	if got := c.Value("FILE_PATTERNS"); !reflect.DeepEqual(got, []string{"*.h", "*.hpp", "*.hh"}) {
+		t.Errorf("unexpected file patterns: %v", got)
+	}
+	if latex, _ := c.Bool("GENERATE_LATEX"); latex {
+		t.Error("expected LaTeX output to be disabled")
+	}
+	if html, _ := c.Bool("GENERATE_HTML"); !html {
+		t.Error("expected HTML output to be enabled by default")
+	}
+	if err := c.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	c, err := doxyfile.Parse(strings.NewReader(sample), "testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Set("INPUT", "include", "src")
+	c.SetBool("GENERATE_XML", true)
+	c.Append("ALIASES", `threadsafe=\par Thread safety`)
+
+	buf := bytes.Buffer{}
+	if err := c.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `# Project settings
+@INCLUDE               = base.cfg
+PROJECT_NAME           = "My \"Best\" Project"
+FILE_PATTERNS         += *.hpp \
+                         *.hh
+INPUT                  = include src
+
+# Output
+GENERATE_LATEX         = NO
+GENERATE_XML           = YES
+ALIASES               += "threadsafe=\par Thread safety"
+# end
+`
+	if buf.String() != want {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestWriteUnchanged(t *testing.T) {
+	in := "# Settings\r\nPROJECT_NAME=\"Odd\"\r\nINPUT =  a \\\r\n\tb\r\nRECURSIVE = NO\r\n"
+	c, err := doxyfile.Parse(strings.NewReader(in), "testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := bytes.Buffer{}
+	if err := c.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != in {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+
+	c.SetBool("RECURSIVE", true)
+	buf.Reset()
+	if err := c.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "# Settings\r\nPROJECT_NAME=\"Odd\"\r\nINPUT =  a \\\r\n\tb\r\nRECURSIVE              = YES\r\n"
+	if buf.String() != want {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+}
+
+func TestWriteQuoted(t *testing.T) {
+	values := []string{`C:\Program Files\`, `C:\dir\`, `say "hi"`, `a\"b`, `\\server\share\\`, `x\y z`}
+	c := doxyfile.New()
+	c.Set("INPUT", values...)
+
+	buf := bytes.Buffer{}
+	if err := c.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := doxyfile.Parse(&buf, "testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := parsed.Value("INPUT"); !reflect.DeepEqual(got, values) {
+		t.Errorf("got %q, want %q", got, values)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	c := doxyfile.New()
+	c.Set("RECURSIVE", "MAYBE")
+	c.Set("NOT_AN_OPTION", "1")
+
+	var errs doxygen.Errors
+	if !errors.As(c.Validate(), &errs) || len(errs) != 2 {
+		t.Errorf("expected 2 errors, got %v", c.Validate())
+	}
+
+	generated := `ABBREVIATE_BRIEF       = "The $name class" is provides
+SHORT_NAMES            = NO
+INLINE_INHERITED_MEMB  = NO
+PYTHON_DOCSTRING       = YES
+INPUT_FILTER           =
+CLASS_GRAPH            = YES
+DOT_FONTNAME           = Helvetica
+`
+	c, err := doxyfile.Parse(strings.NewReader(generated), "testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("unexpected errors: %v", err)
+	}
+}
@@ -0,0 +1,448 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxyfile
+
+// Type is the type of values accepted by an option.
+type Type int
+
+const (
+	String Type = iota
+	Bool
+	Int
+	Enum
+	List
+)
+
+// Option describes a known configuration key.
+//
+// For more details, see: https://doxygen.nl/manual/config.html
+type Option struct {
+	Name    string
+	Type    Type
+	Default []string
+	// Values lists accepted values of Enum options.
+	Values []string
+}
+
+func str(name, def string) Option {
+	if def == "" {
+		return Option{Name: name, Type: String}
+	}
+	return Option{Name: name, Type: String, Default: []string{def}}
+}
+
+func boolean(name string, def bool) Option {
+	if def {
+		return Option{Name: name, Type: Bool, Default: []string{"YES"}}
+	}
+	return Option{Name: name, Type: Bool, Default: []string{"NO"}}
+}
+
+func integer(name, def string) Option {
+	return Option{Name: name, Type: Int, Default: []string{def}}
+}
+
+func list(name string) Option {
+	return Option{Name: name, Type: List}
+}
+
+func enum(name, def string, values ...string) Option {
+	return Option{Name: name, Type: Enum, Default: []string{def}, Values: values}
+}
+
+// Options lists known configuration keys in the order used by `doxygen -g`,
+// followed by obsolete keys which may still be found in older files.
+var Options = []Option{
+	// Project related options.
+	str("DOXYFILE_ENCODING", "UTF-8"),
+	str("PROJECT_NAME", "My Project"),
+	str("PROJECT_NUMBER", ""),
+	str("PROJECT_BRIEF", ""),
+	str("PROJECT_LOGO", ""),
+	str("PROJECT_ICON", ""),
+	str("OUTPUT_DIRECTORY", ""),
+	boolean("CREATE_SUBDIRS", false),
+	integer("CREATE_SUBDIRS_LEVEL", "8"),
+	boolean("ALLOW_UNICODE_NAMES", false),
+	str("OUTPUT_LANGUAGE", "English"),
+	boolean("BRIEF_MEMBER_DESC", true),
+	boolean("REPEAT_BRIEF", true),
+	list("ABBREVIATE_BRIEF"),
+	boolean("ALWAYS_DETAILED_SEC", false),
+	boolean("INLINE_INHERITED_MEMB", false),
+	boolean("FULL_PATH_NAMES", true),
+	list("STRIP_FROM_PATH"),
+	list("STRIP_FROM_INC_PATH"),
+	boolean("SHORT_NAMES", false),
+	boolean("JAVADOC_AUTOBRIEF", false),
+	boolean("JAVADOC_BANNER", false),
+	boolean("QT_AUTOBRIEF", false),
+	boolean("MULTILINE_CPP_IS_BRIEF", false),
+	boolean("PYTHON_DOCSTRING", true),
+	boolean("INHERIT_DOCS", true),
+	boolean("SEPARATE_MEMBER_PAGES", false),
+	integer("TAB_SIZE", "4"),
+	list("ALIASES"),
+	boolean("OPTIMIZE_OUTPUT_FOR_C", false),
+	boolean("OPTIMIZE_OUTPUT_JAVA", false),
+	boolean("OPTIMIZE_FOR_FORTRAN", false),
+	boolean("OPTIMIZE_OUTPUT_VHDL", false),
+	boolean("OPTIMIZE_OUTPUT_SLICE", false),
+	list("EXTENSION_MAPPING"),
+	boolean("MARKDOWN_SUPPORT", true),
+	integer("TOC_INCLUDE_HEADINGS", "5"),
+	enum("MARKDOWN_ID_STYLE", "DOXYGEN", "DOXYGEN", "GITHUB"),
+	boolean("AUTOLINK_SUPPORT", true),
+	boolean("BUILTIN_STL_SUPPORT", false),
+	boolean("CPP_CLI_SUPPORT", false),
+	boolean("SIP_SUPPORT", false),
+	boolean("IDL_PROPERTY_SUPPORT", true),
+	boolean("DISTRIBUTE_GROUP_DOC", false),
+	boolean("GROUP_NESTED_COMPOUNDS", false),
+	boolean("SUBGROUPING", true),
+	boolean("INLINE_GROUPED_CLASSES", false),
+	boolean("INLINE_SIMPLE_STRUCTS", false),
+	boolean("TYPEDEF_HIDES_STRUCT", false),
+	integer("LOOKUP_CACHE_SIZE", "0"),
+	integer("NUM_PROC_THREADS", "1"),
+	enum("TIMESTAMP", "NO", "NO", "YES", "DATETIME", "DATE"),
+
+	// Build related options.
+	boolean("EXTRACT_ALL", false),
+	boolean("EXTRACT_PRIVATE", false),
+	boolean("EXTRACT_PRIV_VIRTUAL", false),
+	boolean("EXTRACT_PACKAGE", false),
+	boolean("EXTRACT_STATIC", false),
+	boolean("EXTRACT_LOCAL_CLASSES", true),
+	boolean("EXTRACT_LOCAL_METHODS", false),
+	boolean("EXTRACT_ANON_NSPACES", false),
+	boolean("RESOLVE_UNNAMED_PARAMS", true),
+	boolean("HIDE_UNDOC_MEMBERS", false),
+	boolean("HIDE_UNDOC_CLASSES", false),
+	boolean("HIDE_FRIEND_COMPOUNDS", false),
+	boolean("HIDE_IN_BODY_DOCS", false),
+	boolean("INTERNAL_DOCS", false),
+	enum("CASE_SENSE_NAMES", "SYSTEM", "SYSTEM", "YES", "NO"),
+	boolean("HIDE_SCOPE_NAMES", false),
+	boolean("HIDE_COMPOUND_REFERENCE", false),
+	boolean("SHOW_HEADERFILE", true),
+	boolean("SHOW_INCLUDE_FILES", true),
+	boolean("SHOW_GROUPED_MEMB_INC", false),
+	boolean("FORCE_LOCAL_INCLUDES", false),
+	boolean("INLINE_INFO", true),
+	boolean("SORT_MEMBER_DOCS", true),
+	boolean("SORT_BRIEF_DOCS", false),
+	boolean("SORT_MEMBERS_CTORS_1ST", false),
+	boolean("SORT_GROUP_NAMES", false),
+	boolean("SORT_BY_SCOPE_NAME", false),
+	boolean("STRICT_PROTO_MATCHING", false),
+	boolean("GENERATE_TODOLIST", true),
+	boolean("GENERATE_TESTLIST", true),
+	boolean("GENERATE_BUGLIST", true),
+	boolean("GENERATE_DEPRECATEDLIST", true),
+	list("ENABLED_SECTIONS"),
+	integer("MAX_INITIALIZER_LINES", "30"),
+	boolean("SHOW_USED_FILES", true),
+	boolean("SHOW_FILES", true),
+	boolean("SHOW_NAMESPACES", true),
+	str("FILE_VERSION_FILTER", ""),
+	str("LAYOUT_FILE", ""),
+	list("CITE_BIB_FILES"),
+	str("EXTERNAL_TOOL_PATH", ""),
+
+	// Options related to warning and progress messages.
+	boolean("QUIET", false),
+	boolean("WARNINGS", true),
+	boolean("WARN_IF_UNDOCUMENTED", true),
+	boolean("WARN_IF_DOC_ERROR", true),
+	boolean("WARN_IF_INCOMPLETE_DOC", true),
+	boolean("WARN_NO_PARAMDOC", false),
+	boolean("WARN_IF_UNDOC_ENUM_VAL", false),
+	boolean("WARN_LAYOUT_FILE", true),
+	enum("WARN_AS_ERROR", "NO", "NO", "YES", "FAIL_ON_WARNINGS", "FAIL_ON_WARNINGS_PRINT"),
+	str("WARN_FORMAT", "$file:$line: $text"),
+	str("WARN_LINE_FORMAT", "at line $line of file $file"),
+	str("WARN_LOGFILE", ""),
+
+	// Options related to the input files.
+	list("INPUT"),
+	str("INPUT_ENCODING", "UTF-8"),
+	list("INPUT_FILE_ENCODING"),
+	list("FILE_PATTERNS"),
+	boolean("RECURSIVE", false),
+	list("EXCLUDE"),
+	boolean("EXCLUDE_SYMLINKS", false),
+	list("EXCLUDE_PATTERNS"),
+	list("EXCLUDE_SYMBOLS"),
+	list("EXAMPLE_PATH"),
+	list("EXAMPLE_PATTERNS"),
+	boolean("EXAMPLE_RECURSIVE", false),
+	list("IMAGE_PATH"),
+	str("INPUT_FILTER", ""),
+	list("FILTER_PATTERNS"),
+	boolean("FILTER_SOURCE_FILES", false),
+	list("FILTER_SOURCE_PATTERNS"),
+	str("USE_MDFILE_AS_MAINPAGE", ""),
+	boolean("IMPLICIT_DIR_DOCS", true),
+	integer("FORTRAN_COMMENT_AFTER", "72"),
+
+	// Configuration options related to source browsing.
+	boolean("SOURCE_BROWSER", false),
+	boolean("INLINE_SOURCES", false),
+	boolean("STRIP_CODE_COMMENTS", true),
+	boolean("REFERENCED_BY_RELATION", false),
+	boolean("REFERENCES_RELATION", false),
+	boolean("REFERENCES_LINK_SOURCE", true),
+	boolean("SOURCE_TOOLTIPS", true),
+	boolean("USE_HTAGS", false),
+	boolean("VERBATIM_HEADERS", true),
+	boolean("CLANG_ASSISTED_PARSING", false),
+	boolean("CLANG_ADD_INC_PATHS", true),
+	list("CLANG_OPTIONS"),
+	str("CLANG_DATABASE_PATH", ""),
+
+	// Configuration options related to the alphabetical class index.
+	boolean("ALPHABETICAL_INDEX", true),
+	list("IGNORE_PREFIX"),
+
+	// Configuration options related to the HTML output.
+	boolean("GENERATE_HTML", true),
+	str("HTML_OUTPUT", "html"),
+	str("HTML_FILE_EXTENSION", ".html"),
+	str("HTML_HEADER", ""),
+	str("HTML_FOOTER", ""),
+	str("HTML_STYLESHEET", ""),
+	list("HTML_EXTRA_STYLESHEET"),
+	list("HTML_EXTRA_FILES"),
+	enum("HTML_COLORSTYLE", "AUTO_LIGHT", "LIGHT", "DARK", "AUTO_LIGHT", "AUTO_DARK", "TOGGLE"),
+	integer("HTML_COLORSTYLE_HUE", "220"),
+	integer("HTML_COLORSTYLE_SAT", "100"),
+	integer("HTML_COLORSTYLE_GAMMA", "80"),
+	boolean("HTML_DYNAMIC_MENUS", true),
+	boolean("HTML_DYNAMIC_SECTIONS", false),
+	boolean("HTML_CODE_FOLDING", true),
+	boolean("HTML_COPY_CLIPBOARD", true),
+	str("HTML_PROJECT_COOKIE", ""),
+	integer("HTML_INDEX_NUM_ENTRIES", "100"),
+	boolean("GENERATE_DOCSET", false),
+	str("DOCSET_FEEDNAME", "Doxygen generated docs"),
+	str("DOCSET_FEEDURL", ""),
+	str("DOCSET_BUNDLE_ID", "org.doxygen.Project"),
+	str("DOCSET_PUBLISHER_ID", "org.doxygen.Publisher"),
+	str("DOCSET_PUBLISHER_NAME", "Publisher"),
+	boolean("GENERATE_HTMLHELP", false),
+	str("CHM_FILE", ""),
+	str("HHC_LOCATION", ""),
+	boolean("GENERATE_CHI", false),
+	str("CHM_INDEX_ENCODING", ""),
+	boolean("BINARY_TOC", false),
+	boolean("TOC_EXPAND", false),
+	str("SITEMAP_URL", ""),
+	boolean("GENERATE_QHP", false),
+	str("QCH_FILE", ""),
+	str("QHP_NAMESPACE", "org.doxygen.Project"),
+	str("QHP_VIRTUAL_FOLDER", "doc"),
+	str("QHP_CUST_FILTER_NAME", ""),
+	list("QHP_CUST_FILTER_ATTRS"),
+	list("QHP_SECT_FILTER_ATTRS"),
+	str("QHG_LOCATION", ""),
+	boolean("GENERATE_ECLIPSEHELP", false),
+	str("ECLIPSE_DOC_ID", "org.doxygen.Project"),
+	boolean("DISABLE_INDEX", false),
+	boolean("GENERATE_TREEVIEW", false),
+	boolean("FULL_SIDEBAR", false),
+	integer("ENUM_VALUES_PER_LINE", "4"),
+	integer("TREEVIEW_WIDTH", "250"),
+	boolean("EXT_LINKS_IN_WINDOW", false),
+	boolean("OBFUSCATE_EMAILS", true),
+	enum("HTML_FORMULA_FORMAT", "png", "png", "svg"),
+	integer("FORMULA_FONTSIZE", "10"),
+	str("FORMULA_MACROFILE", ""),
+	boolean("USE_MATHJAX", false),
+	enum("MATHJAX_VERSION", "MathJax_2", "MathJax_2", "MathJax_3"),
+	enum("MATHJAX_FORMAT", "HTML-CSS", "HTML-CSS", "NativeMML", "chtml", "SVG"),
+	str("MATHJAX_RELPATH", ""),
+	list("MATHJAX_EXTENSIONS"),
+	str("MATHJAX_CODEFILE", ""),
+	boolean("SEARCHENGINE", true),
+	boolean("SERVER_BASED_SEARCH", false),
+	boolean("EXTERNAL_SEARCH", false),
+	str("SEARCHENGINE_URL", ""),
+	str("SEARCHDATA_FILE", "searchdata.xml"),
+	str("EXTERNAL_SEARCH_ID", ""),
+	list("EXTRA_SEARCH_MAPPINGS"),
+
+	// Configuration options related to the LaTeX output.
+	boolean("GENERATE_LATEX", true),
+	str("LATEX_OUTPUT", "latex"),
+	str("LATEX_CMD_NAME", ""),
+	str("MAKEINDEX_CMD_NAME", "makeindex"),
+	str("LATEX_MAKEINDEX_CMD", "makeindex"),
+	boolean("COMPACT_LATEX", false),
+	enum("PAPER_TYPE", "a4", "a4", "letter", "executive", "legal"),
+	list("EXTRA_PACKAGES"),
+	str("LATEX_HEADER", ""),
+	str("LATEX_FOOTER", ""),
+	list("LATEX_EXTRA_STYLESHEET"),
+	list("LATEX_EXTRA_FILES"),
+	boolean("PDF_HYPERLINKS", true),
+	boolean("USE_PDFLATEX", true),
+	enum("LATEX_BATCHMODE", "NO", "NO", "YES", "BATCH", "NON_STOP", "SCROLL", "ERROR_STOP"),
+	boolean("LATEX_HIDE_INDICES", false),
+	str("LATEX_BIB_STYLE", "plain"),
+	str("LATEX_EMOJI_DIRECTORY", ""),
+
+	// Configuration options related to the RTF output.
+	boolean("GENERATE_RTF", false),
+	str("RTF_OUTPUT", "rtf"),
+	boolean("COMPACT_RTF", false),
+	boolean("RTF_HYPERLINKS", false),
+	str("RTF_STYLESHEET_FILE", ""),
+	str("RTF_EXTENSIONS_FILE", ""),
+	list("RTF_EXTRA_FILES"),
+
+	// Configuration options related to the man page output.
+	boolean("GENERATE_MAN", false),
+	str("MAN_OUTPUT", "man"),
+	str("MAN_EXTENSION", ".3"),
+	str("MAN_SUBDIR", ""),
+	boolean("MAN_LINKS", false),
+
+	// Configuration options related to the XML output.
+	boolean("GENERATE_XML", false),
+	str("XML_OUTPUT", "xml"),
+	boolean("XML_PROGRAMLISTING", true),
+	boolean("XML_NS_MEMB_FILE_SCOPE", false),
+
+	// Configuration options related to the DOCBOOK output.
+	boolean("GENERATE_DOCBOOK", false),
+	str("DOCBOOK_OUTPUT", "docbook"),
+
+	// Configuration options for the AutoGen Definitions output.
+	boolean("GENERATE_AUTOGEN_DEF", false),
+
+	// Configuration options related to the Sqlite3 output.
+	boolean("GENERATE_SQLITE3", false),
+	str("SQLITE3_OUTPUT", "sqlite3"),
+	boolean("SQLITE3_RECREATE_DB", true),
+
+	// Configuration options related to the Perl module output.
+	boolean("GENERATE_PERLMOD", false),
+	boolean("PERLMOD_LATEX", false),
+	boolean("PERLMOD_PRETTY", true),
+	str("PERLMOD_MAKEVAR_PREFIX", ""),
+
+	// Configuration options related to the preprocessor.
+	boolean("ENABLE_PREPROCESSING", true),
+	boolean("MACRO_EXPANSION", false),
+	boolean("EXPAND_ONLY_PREDEF", false),
+	boolean("SEARCH_INCLUDES", true),
+	list("INCLUDE_PATH"),
+	list("INCLUDE_FILE_PATTERNS"),
+	list("PREDEFINED"),
+	list("EXPAND_AS_DEFINED"),
+	boolean("SKIP_FUNCTION_MACROS", true),
+
+	// Configuration options related to external references.
+	list("TAGFILES"),
+	str("GENERATE_TAGFILE", ""),
+	boolean("ALLEXTERNALS", false),
+	boolean("EXTERNAL_GROUPS", true),
+	boolean("EXTERNAL_PAGES", true),
+
+	// Configuration options related to diagrams.
+	boolean("HIDE_UNDOC_RELATIONS", true),
+	boolean("HAVE_DOT", false),
+	integer("DOT_NUM_THREADS", "0"),
+	str("DOT_COMMON_ATTR", "fontname=Helvetica,fontsize=10"),
+	str("DOT_EDGE_ATTR", "labelfontname=Helvetica,labelfontsize=10"),
+	str("DOT_NODE_ATTR", "shape=box,height=0.2,width=0.4"),
+	str("DOT_FONTPATH", ""),
+	enum("CLASS_GRAPH", "YES", "YES", "NO", "TEXT", "GRAPH", "BUILTIN"),
+	boolean("COLLABORATION_GRAPH", true),
+	boolean("GROUP_GRAPHS", true),
+	boolean("UML_LOOK", false),
+	integer("UML_LIMIT_NUM_FIELDS", "10"),
+	enum("DOT_UML_DETAILS", "NO", "NO", "YES", "NONE"),
+	integer("DOT_WRAP_THRESHOLD", "17"),
+	boolean("TEMPLATE_RELATIONS", false),
+	boolean("INCLUDE_GRAPH", true),
+	boolean("INCLUDED_BY_GRAPH", true),
+	boolean("CALL_GRAPH", false),
+	boolean("CALLER_GRAPH", false),
+	boolean("GRAPHICAL_HIERARCHY", true),
+	boolean("DIRECTORY_GRAPH", true),
+	integer("DIR_GRAPH_MAX_DEPTH", "1"),
+	enum("DOT_IMAGE_FORMAT", "png", "png", "jpg", "gif", "svg", "png:gd", "png:gd:gd", "png:cairo", "png:cairo:gd", "png:cairo:cairo", "png:cairo:gdiplus", "png:gdiplus", "png:gdiplus:gdiplus", "svg:cairo", "svg:cairo:cairo", "svg:svg", "svg:svg:core", "gif:cairo", "gif:cairo:gd", "gif:cairo:gdiplus", "gif:gdiplus", "gif:gdiplus:gdiplus", "gif:gd", "gif:gd:gd", "jpg:cairo", "jpg:cairo:gd", "jpg:cairo:gdiplus", "jpg:gd", "jpg:gd:gd", "jpg:gdiplus", "jpg:gdiplus:gdiplus"),
+	boolean("INTERACTIVE_SVG", false),
+	str("DOT_PATH", ""),
+	list("DOTFILE_DIRS"),
+	str("DIA_PATH", ""),
+	list("DIAFILE_DIRS"),
+	str("PLANTUML_JAR_PATH", ""),
+	str("PLANTUML_CFG_FILE", ""),
+	list("PLANTUML_INCLUDE_PATH"),
+	integer("DOT_GRAPH_MAX_NODES", "50"),
+	integer("MAX_DOT_GRAPH_DEPTH", "0"),
+	boolean("DOT_MULTI_TARGETS", false),
+	boolean("GENERATE_LEGEND", true),
+	boolean("DOT_CLEANUP", true),
+	str("MSCGEN_TOOL", ""),
+	list("MSCFILE_DIRS"),
+
+	// Obsolete options, still accepted by doxygen with a warning.
+	boolean("CLASS_DIAGRAMS", true),
+	integer("COLS_IN_ALPHA_INDEX", "5"),
+	boolean("DOCBOOK_PROGRAMLISTING", false),
+	str("DOT_FONTNAME", "Helvetica"),
+	integer("DOT_FONTSIZE", "10"),
+	boolean("DOT_TRANSPARENT", false),
+	boolean("FORMULA_TRANSPARENT", true),
+	boolean("HTML_TIMESTAMP", false),
+	boolean("LATEX_SOURCE_CODE", false),
+	boolean("LATEX_TIMESTAMP", false),
+	str("MSCGEN_PATH", ""),
+	enum("OUTPUT_TEXT_DIRECTION", "None", "None", "LTR", "RTL"),
+	str("PERL_PATH", "/usr/bin/perl"),
+	boolean("RTF_SOURCE_CODE", false),
+	integer("SYMBOL_CACHE_SIZE", "0"),
+	list("TCL_SUBST"),
+}
+
+var known = func() map[string]Option {
+	m := map[string]Option{}
+	for _, opt := range Options {
+		m[opt.Name] = opt
+	}
+	return m
+}()
+
+// Lookup returns description of a known configuration key.
+func Lookup(key string) (Option, bool) {
+	opt, ok := known[key]
+	return opt, ok
+}
@@ -0,0 +1,241 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxyfile
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MaxIncludeDepth limits nesting of `@INCLUDE` directives.
+const MaxIncludeDepth = 32
+
+// ParseFile reads Doxyfile from the given path.
+func ParseFile(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p := parser{}
+	return p.parse(f, path)
+}
+
+// Parse reads Doxyfile from r. Files included with `@INCLUDE` are looked up
+// in dir, and then in directories listed in `@INCLUDE_PATH`.
+func Parse(r io.Reader, dir string) (*Config, error) {
+	p := parser{}
+	return p.parse(r, filepath.Join(dir, "Doxyfile"))
+}
+
+type parser struct {
+	depth int
+}
+
+func (p *parser) parse(r io.Reader, path string) (*Config, error) {
+	c := New()
+	var comments []string
+	var includePath []string
+
+	lineno := 0
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	scanner.Split(scanLines)
+	for scanner.Scan() {
+		lineno++
+		if lineno == 1 && strings.HasSuffix(scanner.Text(), "\r") {
+			c.newline = "\r\n"
+		}
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			comments = append(comments, line)
+			continue
+		}
+
+		// join continuation lines
+		lines := []string{line}
+		for strings.HasSuffix(strings.TrimRight(line, " \t"), `\`) && scanner.Scan() {
+			lineno++
+			next := strings.TrimRight(scanner.Text(), "\r")
+			lines = append(lines, next)
+			line = strings.TrimSuffix(strings.TrimRight(line, " \t"), `\`) + " " + next
+		}
+
+		e, err := parseEntry(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineno, err)
+		}
+		e.Comments, comments = comments, nil
+		e.lines = lines
+		e.parsed = &Entry{Key: e.Key, Append: e.Append, Values: append([]string(nil), e.Values...)}
+
+		switch e.Key {
+		case "@INCLUDE_PATH":
+			includePath = append(includePath, e.Values...)
+		case Include:
+			for _, name := range e.Values {
+				inc, err := p.include(name, filepath.Dir(path), includePath)
+				if err != nil {
+					return nil, fmt.Errorf("%s:%d: %w", path, lineno, err)
+				}
+				e.Includes = append(e.Includes, inc)
+			}
+		}
+		c.Entries = append(c.Entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	c.Trailer = comments
+	return c, nil
+}
+
+func (p *parser) include(name, dir string, includePath []string) (*Config, error) {
+	if p.depth >= MaxIncludeDepth {
+		return nil, fmt.Errorf("includes nested deeper than %d levels", MaxIncludeDepth)
+	}
+
+	candidates := []string{name}
+	if !filepath.IsAbs(name) {
+		candidates = []string{filepath.Join(dir, name)}
+		for _, d := range includePath {
+			candidates = append(candidates, filepath.Join(d, name))
+		}
+	}
+
+	for _, path := range candidates {
+		f, err := os.Open(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		sub := parser{depth: p.depth + 1}
+		return sub.parse(f, path)
+	}
+	return nil, fmt.Errorf("included file '%s' not found", name)
+}
+
+func parseEntry(line string) (*Entry, error) {
+	eq := strings.Index(line, "=")
+	if eq < 0 {
+		return nil, fmt.Errorf("expected assignment, got '%s'", strings.TrimSpace(line))
+	}
+
+	e := &Entry{}
+	key := line[:eq]
+	if strings.HasSuffix(key, "+") {
+		e.Append = true
+		key = key[:len(key)-1]
+	}
+	e.Key = strings.TrimSpace(key)
+	for _, r := range e.Key {
+		if !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '@') {
+			return nil, fmt.Errorf("invalid key '%s'", e.Key)
+		}
+	}
+
+	values, err := splitValues(line[eq+1:])
+	if err != nil {
+		return nil, fmt.Errorf("key %s: %w", e.Key, err)
+	}
+	e.Values = values
+	return e, nil
+}
+
+// splitValues splits value of the entry on whitespace, keeping quoted strings
+// together. Within quotes, backslashes are literal unless they precede a
+// quote: then each pair of them stands for a single backslash, and an odd one
+// escapes the quote.
+func splitValues(s string) ([]string, error) {
+	var values []string
+	sb := strings.Builder{}
+	inQuotes, inValue := false, false
+
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case inQuotes && ch == '\\':
+			n := len(s[i:]) - len(strings.TrimLeft(s[i:], `\`))
+			if i+n == len(s) || s[i+n] != '"' {
+				sb.WriteString(s[i : i+n])
+			} else {
+				sb.WriteString(strings.Repeat(`\`, n/2))
+				if n%2 == 1 {
+					sb.WriteByte('"')
+					n++
+				}
+			}
+			i += n - 1
+		case ch == '"':
+			inQuotes = !inQuotes
+			inValue = true
+		case !inQuotes && (ch == ' ' || ch == '\t'):
+			if inValue {
+				values = append(values, sb.String())
+				sb.Reset()
+				inValue = false
+			}
+		default:
+			sb.WriteByte(ch)
+			inValue = true
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quoted string")
+	}
+	if inValue {
+		values = append(values, sb.String())
+	}
+	return values, nil
+}
+
+// scanLines is bufio.ScanLines keeping carriage return at the end of line, so
+// that line endings of the file can be detected.
+func scanLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
@@ -0,0 +1,127 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxyfile
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+const (
+	keyWidth  = 22
+	lineWidth = 80
+)
+
+// Write writes the configuration. Entries are written in their order, each
+// preceded by its comments. Entries read from a file and not modified since
+// are written exactly as they were read, in its line endings, others are
+// formatted by Entry.String. `@INCLUDE` directives are written as they are,
+// included files are not modified.
+func (c *Config) Write(w io.Writer) error {
+	newline := c.newline
+	if newline == "" {
+		newline = "\n"
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, e := range c.Entries {
+		for _, comment := range e.Comments {
+			bw.WriteString(comment + newline)
+		}
+		if e.modified() {
+			bw.WriteString(strings.ReplaceAll(e.String(), "\n", newline) + newline)
+			continue
+		}
+		for _, line := range e.lines {
+			bw.WriteString(line + newline)
+		}
+	}
+	for _, comment := range c.Trailer {
+		bw.WriteString(comment + newline)
+	}
+	return bw.Flush()
+}
+
+// String returns the entry formatted as a single Doxyfile line, continued on
+// the following lines if too long.
+func (e Entry) String() string {
+	// Operators are aligned on the equal sign.
+	head := padRight(e.Key, keyWidth) + " ="
+	if e.Append {
+		head = padRight(e.Key, keyWidth-1) + " +="
+	}
+
+	values := make([]string, 0, len(e.Values))
+	length := len(head)
+	for _, v := range e.Values {
+		values = append(values, quote(v))
+		length += len(values[len(values)-1]) + 1
+	}
+	if len(values) == 0 {
+		return head
+	}
+	if len(values) == 1 || length <= lineWidth {
+		return head + " " + strings.Join(values, " ")
+	}
+
+	indent := strings.Repeat(" ", len(head)+1)
+	return head + " " + strings.Join(values, " \\\n"+indent)
+}
+
+func padRight(s string, width int) string {
+	if len(s) >= width {
+		return s
+	}
+	return s + strings.Repeat(" ", width-len(s))
+}
+
+// quote returns the value quoted if needed. Backslashes preceding a quote or
+// the end of the value are doubled, so that they are not read as escapes.
+func quote(v string) string {
+	if v != "" && !strings.ContainsAny(v, " \t\"#") && !strings.HasSuffix(v, `\`) {
+		return v
+	}
+
+	sb := strings.Builder{}
+	sb.WriteByte('"')
+	backslashes := 0
+	for i := 0; i < len(v); i++ {
+		switch v[i] {
+		case '\\':
+			backslashes++
+			continue
+		case '"':
+			sb.WriteString(strings.Repeat(`\`, 2*backslashes) + `\"`)
+		default:
+			sb.WriteString(strings.Repeat(`\`, backslashes) + string(v[i]))
+		}
+		backslashes = 0
+	}
+	sb.WriteString(strings.Repeat(`\`, 2*backslashes) + `"`)
+	return sb.String()
+}
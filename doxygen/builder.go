@@ -0,0 +1,393 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+)
+
+// builder holds the state shared by all specialized builders. Methods return
+// self, so they can be chained on the specialized builder type.
+type builder[T any] struct {
+	self     T
+	name     string
+	commands []command.Command
+	errs     Errors
+	hasBrief bool
+}
+
+func (b *builder[T]) add(cmd command.Command) T {
+	b.commands = append(b.commands, cmd)
+	return b.self
+}
+
+func (b *builder[T]) errorf(format string, args ...interface{}) T {
+	b.errs = append(b.errs, fmt.Errorf("%s: "+format, append([]interface{}{b.name}, args...)...))
+	return b.self
+}
+
+func (b *builder[T]) requireWord(what, value string) bool {
+	if value == "" {
+		b.errorf("%s must not be empty", what)
+		return false
+	}
+	if strings.ContainsAny(value, " \t\r\n") {
+		b.errorf("%s '%s' is not a single word", what, value)
+		return false
+	}
+	return true
+}
+
+// Brief adds a brief description. It may be called only once.
+func (b *builder[T]) Brief(text string) T {
+	if text == "" {
+		return b.errorf("brief description must not be empty")
+	}
+	if b.hasBrief {
+		return b.errorf("brief description already set")
+	}
+	b.hasBrief = true
+	return b.add(command.Brief{BriefDescription: text})
+}
+
+// Details adds a detailed description.
+func (b *builder[T]) Details(text string) T {
+	return b.add(command.Details{DetailedDescription: text})
+}
+
+// See adds references to related entities.
+func (b *builder[T]) See(references ...string) T {
+	if len(references) == 0 {
+		return b.errorf("see requires at least one reference")
+	}
+	return b.add(command.See{References: references})
+}
+
+// Deprecated marks the entity as deprecated.
+func (b *builder[T]) Deprecated(description string) T {
+	return b.add(command.Deprecated{Description: description})
+}
+
+// Note adds a note.
+func (b *builder[T]) Note(text string) T {
+	return b.add(command.Note{Text: text})
+}
+
+// Since documents the version in which the entity was introduced.
+func (b *builder[T]) Since(version, text string) T {
+	if version == "" {
+		return b.errorf("since requires a version")
+	}
+	return b.add(command.Since{Version: version, Text: text})
+}
+
+// Command adds arbitrary command.
+func (b *builder[T]) Command(cmd command.Command) T {
+	if cmd == nil {
+		return b.errorf("command must not be nil")
+	}
+	return b.add(cmd)
+}
+
+// Build returns the block, or all errors collected while building it.
+func (b *builder[T]) Build() (*Doxygen, error) {
+	if err := b.errs.Err(); err != nil {
+		return nil, err
+	}
+	commands := make([]command.Command, len(b.commands))
+	copy(commands, b.commands)
+	return New(WithMultipleCommands(commands...)), nil
+}
+
+// MustBuild works like Build, but panics on error.
+func (b *builder[T]) MustBuild() *Doxygen {
+	d, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// FuncBuilder composes documentation of a function.
+type FuncBuilder struct {
+	builder[*FuncBuilder]
+	params       map[string]bool
+	retvals      map[string]bool
+	hasSignature bool
+}
+
+// Func starts documentation of the named function.
+func Func(name string) *FuncBuilder {
+	b := &FuncBuilder{
+		params:  map[string]bool{},
+		retvals: map[string]bool{},
+	}
+	b.self, b.name = b, name
+	b.requireWord("function name", name)
+	return b
+}
+
+// Signature adds `fn` command with complete declaration of the function, for
+// documentation placed away from the declaration.
+func (b *FuncBuilder) Signature(returnType string, parameters ...string) *FuncBuilder {
+	if b.hasSignature {
+		return b.errorf("signature already set")
+	}
+	if err := command.ValidateIdentifier(b.name); err != nil {
+		return b.errorf("%v", err)
+	}
+	b.hasSignature = true
+	b.commands = append([]command.Command{command.Fn{
+		ReturnType: returnType,
+		Name:       b.name,
+		Parameters: parameters,
+	}}, b.commands...)
+	return b
+}
+
+// Param adds a parameter with given direction, which may be empty.
+func (b *FuncBuilder) Param(direction, name, description string) *FuncBuilder {
+	switch direction {
+	case "", "in", "out", "in,out":
+	default:
+		return b.errorf("invalid direction '%s' of parameter '%s'", direction, name)
+	}
+	if !b.requireWord("parameter name", name) {
+		return b
+	}
+	if b.params[name] {
+		return b.errorf("parameter '%s' documented twice", name)
+	}
+	b.params[name] = true
+	return b.add(command.Param{
+		Direction:            direction,
+		ParameterName:        name,
+		ParameterDescription: description,
+	})
+}
+
+// In adds an input parameter.
+func (b *FuncBuilder) In(name, description string) *FuncBuilder {
+	return b.Param("in", name, description)
+}
+
+// Out adds an output parameter.
+func (b *FuncBuilder) Out(name, description string) *FuncBuilder {
+	return b.Param("out", name, description)
+}
+
+// InOut adds a parameter used both for input and output.
+func (b *FuncBuilder) InOut(name, description string) *FuncBuilder {
+	return b.Param("in,out", name, description)
+}
+
+// Tparam adds a template parameter.
+func (b *FuncBuilder) Tparam(name, description string) *FuncBuilder {
+	if !b.requireWord("template parameter name", name) {
+		return b
+	}
+	return b.add(command.Tparam{TemplateParameterName: name, TemplateParameterDescription: description})
+}
+
+// Pre adds a precondition.
+func (b *FuncBuilder) Pre(description string) *FuncBuilder {
+	return b.add(command.Pre{Description: description})
+}
+
+// Post adds a postcondition.
+func (b *FuncBuilder) Post(description string) *FuncBuilder {
+	return b.add(command.Post{Description: description})
+}
+
+// Retval documents a specific return value.
+func (b *FuncBuilder) Retval(value, description string) *FuncBuilder {
+	if !b.requireWord("return value", value) {
+		return b
+	}
+	if b.retvals[value] {
+		return b.errorf("return value '%s' documented twice", value)
+	}
+	b.retvals[value] = true
+	return b.add(command.Retval{Name: value, Message: description})
+}
+
+// Returns describes the return value.
+func (b *FuncBuilder) Returns(description string) *FuncBuilder {
+	return b.add(command.Returns{Description: description})
+}
+
+// Throws documents an exception thrown by the function.
+func (b *FuncBuilder) Throws(object, description string) *FuncBuilder {
+	if !b.requireWord("exception object", object) {
+		return b
+	}
+	return b.add(command.Throws{ExceptionObject: object, ExceptionDescription: description})
+}
+
+// TypeBuilder composes documentation of a class, struct, union or an
+// enumeration.
+type TypeBuilder struct {
+	builder[*TypeBuilder]
+}
+
+func newTypeBuilder(name string, structural func(string) command.Command) *TypeBuilder {
+	b := &TypeBuilder{}
+	b.self, b.name = b, name
+	if b.requireWord("type name", name) {
+		b.add(structural(name))
+	}
+	return b
+}
+
+// Class starts documentation of the named class.
+func Class(name string) *TypeBuilder {
+	return newTypeBuilder(name, func(name string) command.Command {
+		return command.Class{Name: name}
+	})
+}
+
+// Struct starts documentation of the named struct.
+func Struct(name string) *TypeBuilder {
+	return newTypeBuilder(name, func(name string) command.Command {
+		return command.Struct{Name: name}
+	})
+}
+
+// Union starts documentation of the named union.
+func Union(name string) *TypeBuilder {
+	return newTypeBuilder(name, func(name string) command.Command {
+		return command.Union{Name: name}
+	})
+}
+
+// Enum starts documentation of the named enumeration.
+func Enum(name string) *TypeBuilder {
+	return newTypeBuilder(name, func(name string) command.Command {
+		return command.Enum{Name: name}
+	})
+}
+
+// Extends documents inheritance, for languages not supporting it natively.
+func (b *TypeBuilder) Extends(name string) *TypeBuilder {
+	if !b.requireWord("base name", name) {
+		return b
+	}
+	return b.add(command.Extends{Name: name})
+}
+
+// Nosubgrouping disables putting member groups inside the public, protected
+// and private sections of the type.
+func (b *TypeBuilder) Nosubgrouping() *TypeBuilder {
+	return b.add(command.Nosubgrouping{})
+}
+
+// HeaderFile documents the header file declaring the type.
+func (b *TypeBuilder) HeaderFile(file, name string) *TypeBuilder {
+	if !b.requireWord("header file", file) {
+		return b
+	}
+	return b.add(command.HeaderFile{File: file, Name: name})
+}
+
+// FileBuilder composes documentation of a file.
+type FileBuilder struct {
+	builder[*FileBuilder]
+}
+
+// File starts documentation of the named file. Empty name documents the file
+// containing the block.
+func File(name string) *FileBuilder {
+	b := &FileBuilder{}
+	b.self, b.name = b, name
+	if name != "" && !b.requireWord("file name", name) {
+		return b
+	}
+	if b.name == "" {
+		b.name = "file"
+	}
+	return b.add(command.File{Name: name})
+}
+
+// Author adds authors of the file.
+func (b *FileBuilder) Author(names ...string) *FileBuilder {
+	if len(names) == 0 {
+		return b.errorf("author requires at least one name")
+	}
+	return b.add(command.Authors{ListOfAuthors: names})
+}
+
+// Version adds version of the file.
+func (b *FileBuilder) Version(number string) *FileBuilder {
+	return b.add(command.Version{Number: number})
+}
+
+// Date adds date of the file.
+func (b *FileBuilder) Date(description string) *FileBuilder {
+	return b.add(command.Date{Description: description})
+}
+
+// Copyright adds copyright holder of the file, given as a single word.
+func (b *FileBuilder) Copyright(holder string) *FileBuilder {
+	if !b.requireWord("copyright holder", holder) {
+		return b
+	}
+	return b.add(command.Copyright{Description: holder})
+}
+
+// GroupBuilder composes documentation of a group.
+type GroupBuilder struct {
+	builder[*GroupBuilder]
+}
+
+// Group starts documentation of the group with given name and title.
+func Group(name, title string) *GroupBuilder {
+	b := &GroupBuilder{}
+	b.self, b.name = b, name
+	if !b.requireWord("group name", name) {
+		return b
+	}
+	if title == "" {
+		return b.errorf("group title must not be empty")
+	}
+	return b.add(command.Defgroup{Name: name, GroupTitle: title})
+}
+
+// Ingroup makes the group a subgroup of given groups.
+func (b *GroupBuilder) Ingroup(groups ...string) *GroupBuilder {
+	if len(groups) == 0 {
+		return b.errorf("ingroup requires at least one group")
+	}
+	for _, group := range groups {
+		if !b.requireWord("group name", group) {
+			return b
+		}
+	}
+	return b.add(command.Ingroup{Groups: groups})
+}
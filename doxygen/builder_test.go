@@ -0,0 +1,83 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+func TestFuncBuilder(t *testing.T) {
+	d, err := doxygen.Func("open").
+		Brief("Opens a file.").
+		In("path", "Path to the file.").
+		Out("fd", "Opened descriptor.").
+		Retval("-1", "On failure.").
+		Returns("Status code.").
+		Throws("std::bad_alloc", "On OOM.").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := emitter.NewEmitter(100)
+	d.Generate(out)
+	want := "/**\n" +
+		"\t\\brief Opens a file.\n" +
+		"\t\\param[in] path Path to the file.\n" +
+		"\t\\param[out] fd Opened descriptor.\n" +
+		"\t\\retval -1 On failure.\n" +
+		"\t\\returns Status code.\n" +
+		"\t\\throws std::bad_alloc On OOM.\n" +
+		"*/\n"
+	if out.String() != want {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestFuncBuilderErrors(t *testing.T) {
+	_, err := doxygen.Func("open").
+		Brief("Opens a file.").
+		Brief("Opens a file again.").
+		In("path", "Path to the file.").
+		In("path", "Duplicate.").
+		Param("sideways", "fd", "Invalid direction.").
+		Build()
+
+	var errs doxygen.Errors
+	if !errors.As(err, &errs) || len(errs) != 3 {
+		t.Errorf("expected 3 errors, got %v", err)
+	}
+
+	_, err = doxygen.Func("1st").Signature("int").Build()
+	if !errors.As(err, &errs) || len(errs) != 1 {
+		t.Errorf("expected invalid identifier error, got %v", err)
+	}
+}
@@ -32,8 +32,10 @@ import (
 )
 
 type Doxygen struct {
-	Commands []command.Command
-	Tag      string
+	Commands   []command.Command
+	Tag        string
+	Order      Less
+	InlineMode string
 }
 
 type Option func(*Doxygen)
@@ -55,6 +57,14 @@ func WithTag(tag string) func(*Doxygen) {
 	}
 }
 
+// WithInlineMode sets the mode in which inline commands, such as B, C or Em,
+// are rendered. See command.InlineMarkdown and related constants.
+func WithInlineMode(mode string) Option {
+	return func(d *Doxygen) {
+		d.InlineMode = mode
+	}
+}
+
 func WithCommand(command command.Command) Option {
 	return func(d *Doxygen) {
 		d.Commands = append(d.Commands, command)
@@ -76,19 +86,26 @@ func WithCommandOnce(command command.Command) Option {
 }
 
 func (d Doxygen) hasCommand(command command.Command) bool {
-	for _, cmd := range d.Commands {
-		if cmd.Command() == command.Command() {
-			return true
-		}
-	}
-	return false
+	return d.index(command) >= 0
 }
 
 func (d Doxygen) Generate(out emitter.Emitter) {
+	if d.InlineMode != command.InlineDefault {
+		out = emitter.WithInlineMode(out, d.InlineMode)
+	}
+
 	out.Println("/**")
 	out.Indent(1)
-	for _, cmd := range d.Commands {
+	tracker, _ := out.(emitter.CommandTracker)
+	for _, i := range d.order() {
+		cmd := d.Commands[i]
+		if tracker != nil {
+			tracker.BeginCommand(i)
+		}
 		cmd.Generate(d.Tag, out)
+		if tracker != nil {
+			tracker.EndCommand(i)
+		}
 	}
 	out.Indent(-1)
 	out.Println("*/")
@@ -0,0 +1,155 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+)
+
+type document struct {
+	Tag        string            `json:"tag,omitempty"`
+	InlineMode string            `json:"inlineMode,omitempty"`
+	Commands   []json.RawMessage `json:"commands"`
+}
+
+// MarshalJSON encodes the block as `{"tag": ..., "commands": [...]}`, where
+// every command carries its registered name under the `cmd` key.
+func (d Doxygen) MarshalJSON() ([]byte, error) {
+	doc := document{
+		Tag:        d.Tag,
+		InlineMode: d.InlineMode,
+		Commands:   make([]json.RawMessage, 0, len(d.Commands)),
+	}
+	for i, cmd := range d.Commands {
+		data, err := command.MarshalJSON(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("command %d: %w", i, err)
+		}
+		doc.Commands = append(doc.Commands, data)
+	}
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSON decodes the block encoded by MarshalJSON. Commands that are
+// not registered in the command package are rejected.
+func (d *Doxygen) UnmarshalJSON(data []byte) error {
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	commands := make([]command.Command, 0, len(doc.Commands))
+	for i, raw := range doc.Commands {
+		cmd, err := command.UnmarshalJSON(raw)
+		if err != nil {
+			return fmt.Errorf("command %d: %w", i, err)
+		}
+		commands = append(commands, cmd)
+	}
+
+	d.Tag = doc.Tag
+	if d.Tag == "" {
+		d.Tag = DefaultTag
+	}
+	d.InlineMode = doc.InlineMode
+	d.Commands = commands
+	return nil
+}
+
+// MarshalYAML returns the same mapping as MarshalJSON in a form accepted by
+// YAML encoders (gopkg.in/yaml.v2 and gopkg.in/yaml.v3).
+func (d Doxygen) MarshalYAML() (interface{}, error) {
+	data, err := d.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// UnmarshalYAML decodes the mapping produced by MarshalYAML.
+func (d *Doxygen) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	v, err := stringKeys(v)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalJSON(data)
+}
+
+// stringKeys converts map[interface{}]interface{} produced by YAML decoders
+// into map[string]interface{} understood by encoding/json.
+func stringKeys(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			s, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected non-string key %v", key)
+			}
+			val, err := stringKeys(val)
+			if err != nil {
+				return nil, err
+			}
+			m[s] = val
+		}
+		return m, nil
+	case map[string]interface{}:
+		for key, val := range v {
+			val, err := stringKeys(val)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = val
+		}
+		return v, nil
+	case []interface{}:
+		for i, val := range v {
+			val, err := stringKeys(val)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = val
+		}
+		return v, nil
+	}
+	return v, nil
+}
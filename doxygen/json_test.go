@@ -0,0 +1,117 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	d := doxygen.New(
+		doxygen.WithCommand(command.Brief{BriefDescription: "Opens a file."}),
+		doxygen.WithCommand(command.Param{Direction: "in", ParameterName: "path", ParameterDescription: "Path to the file."}),
+		doxygen.WithCommand(command.Callgraph{}),
+	)
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"tag":"\\","commands":[` +
+		`{"cmd":"brief","briefDescription":"Opens a file."},` +
+		`{"cmd":"param","direction":"in","parameterName":"path","parameterDescription":"Path to the file."},` +
+		`{"cmd":"callgraph"}]}`
+	if string(data) != want {
+		t.Errorf("unexpected encoding:\n%s", data)
+	}
+
+	var got doxygen.Doxygen
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*d, got) {
+		t.Errorf("round trip mismatch: %#v", got)
+	}
+}
+
+func TestJSONUnknownCommand(t *testing.T) {
+	var d doxygen.Doxygen
+	err := json.Unmarshal([]byte(`{"commands":[{"cmd":"nonexistent"}]}`), &d)
+	if !errors.As(err, &command.ErrUnknownCommand{}) {
+		t.Errorf("expected unknown command error, got %v", err)
+	}
+}
+
+func TestYAMLMapping(t *testing.T) {
+	var d doxygen.Doxygen
+	err := d.UnmarshalYAML(func(v interface{}) error {
+		*(v.(*interface{})) = map[interface{}]interface{}{
+			"commands": []interface{}{
+				map[interface{}]interface{}{"cmd": "brief", "briefDescription": "Closes a file."},
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Commands) != 1 || d.Commands[0] != (command.Brief{BriefDescription: "Closes a file."}) || d.Tag != doxygen.DefaultTag {
+		t.Errorf("unexpected block: %#v", d)
+	}
+}
+
+func TestInlineMode(t *testing.T) {
+	d := doxygen.New(
+		doxygen.WithInlineMode(command.InlineMarkdown),
+		doxygen.WithCommand(command.Brief{BriefDescription: "Closes a file."}),
+		doxygen.WithMultipleCommands(command.Text{Text: "Do "}, command.B{Word: "not"}, command.Text{Text: " retry.\n"}),
+	)
+	out := emitter.NewEmitter(80)
+	d.Generate(out)
+	if want := "/**\n\t\\brief Closes a file.\n\tDo **not** retry.\n*/\n"; out.String() != want {
+		t.Errorf("got %q, want %q", out.String(), want)
+	}
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded doxygen.Doxygen
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.InlineMode != command.InlineMarkdown {
+		t.Errorf("inline mode not decoded: %s", data)
+	}
+}
@@ -0,0 +1,419 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+)
+
+// Marshaler is implemented by types that can produce their own commands.
+type Marshaler interface {
+	MarshalDoxygen() ([]command.Command, error)
+}
+
+// UnsupportedTypeError is returned by Marshal when a tagged field has a type
+// that cannot be converted into the requested command.
+type UnsupportedTypeError struct {
+	Field   string
+	Type    reflect.Type
+	Command string
+}
+
+func (err UnsupportedTypeError) Error() string {
+	return fmt.Sprintf("doxygen: field %s of type %s cannot be marshalled as '%s'", err.Field, err.Type, err.Command)
+}
+
+// textCommands are commands taking a single text argument.
+var textCommands = map[string]func(string) command.Command{
+	"brief":      func(s string) command.Command { return command.Brief{BriefDescription: s} },
+	"details":    func(s string) command.Command { return command.Details{DetailedDescription: s} },
+	"return":     func(s string) command.Command { return command.Return{Description: s} },
+	"returns":    func(s string) command.Command { return command.Returns{Description: s} },
+	"deprecated": func(s string) command.Command { return command.Deprecated{Description: s} },
+	"remark":     func(s string) command.Command { return command.Remark{Text: s} },
+	"warning":    func(s string) command.Command { return command.Warning{Message: s} },
+	"bug":        func(s string) command.Command { return command.Bug{Description: s} },
+	"todo":       func(s string) command.Command { return command.Todo{Description: s} },
+	"version":    func(s string) command.Command { return command.Version{Number: s} },
+	"since":      func(s string) command.Command { return command.Since{Version: s} },
+	"note":       func(s string) command.Command { return command.Note{Text: s} },
+	"pre":        func(s string) command.Command { return command.Pre{Description: s} },
+	"post":       func(s string) command.Command { return command.Post{Description: s} },
+	"invariant":  func(s string) command.Command { return command.Invariant{Description: s} },
+	"result":     func(s string) command.Command { return command.Result{Description: s} },
+	"test":       func(s string) command.Command { return command.Test{Paragraph: s} },
+}
+
+// listCommands are commands taking a list of arguments.
+var listCommands = map[string]func([]string) command.Command{
+	"see":    func(s []string) command.Command { return command.See{References: s} },
+	"sa":     func(s []string) command.Command { return command.Sa{References: s} },
+	"author": func(s []string) command.Command { return command.Authors{ListOfAuthors: s} },
+}
+
+// keyedCommands are commands taking a name followed by a description.
+var keyedCommands = map[string]func(name, description, dir string) command.Command{
+	"param": func(name, description, dir string) command.Command {
+		return command.Param{Direction: dir, ParameterName: name, ParameterDescription: description}
+	},
+	"tparam": func(name, description, _ string) command.Command {
+		return command.Tparam{TemplateParameterName: name, TemplateParameterDescription: description}
+	},
+	"retval": func(name, description, _ string) command.Command {
+		return command.Retval{Name: name, Message: description}
+	},
+	"exception": func(name, description, _ string) command.Command {
+		return command.Exception{ExceptionObject: name, ExceptionDescription: description}
+	},
+	"throws": func(name, description, _ string) command.Command {
+		return command.Throws{ExceptionObject: name, ExceptionDescription: description}
+	},
+}
+
+var directions = map[string]string{
+	"in":    "in",
+	"out":   "out",
+	"inout": "in,out",
+}
+
+type fieldTag struct {
+	command   string
+	name      string
+	dir       string
+	omitempty bool
+}
+
+func parseTag(tag string) (fieldTag, error) {
+	parts := strings.Split(tag, ",")
+	ft := fieldTag{command: parts[0]}
+	for _, opt := range parts[1:] {
+		key, value, _ := strings.Cut(opt, "=")
+		switch key {
+		case "omitempty":
+			ft.omitempty = true
+		case "name":
+			ft.name = value
+		case "dir":
+			dir, ok := directions[value]
+			if !ok {
+				return ft, fmt.Errorf("doxygen: invalid direction '%s'", value)
+			}
+			ft.dir = dir
+		default:
+			return ft, fmt.Errorf("doxygen: unknown tag option '%s'", key)
+		}
+	}
+	return ft, nil
+}
+
+var (
+	commandType   = reflect.TypeOf((*command.Command)(nil)).Elem()
+	marshalerType = reflect.TypeOf((*Marshaler)(nil)).Elem()
+)
+
+// Marshal returns documentation block described by the struct v.
+//
+// Fields are converted according to `doxygen` struct tags, e.g.:
+//
+//	type Function struct {
+//		Brief   string            `doxygen:"brief"`
+//		Path    string            `doxygen:"param,name=path,dir=in"`
+//		Errors  map[string]string `doxygen:"retval,omitempty"`
+//		Returns string            `doxygen:"return,omitempty"`
+//		See     []string          `doxygen:"see,omitempty"`
+//	}
+//
+// Text commands (brief, details, return, note, since, ...) accept strings and
+// slices of strings, producing one command per element. List commands (see,
+// sa, author) accept strings and slices of strings, producing a single
+// command. Keyed commands (param, tparam, retval, exception, throws) accept
+// strings named by the `name` option or by the field name, maps of strings
+// ordered by key, and structs or slices of structs with fields tagged `name`,
+// `description` and `dir`. The `dir` option takes one of `in`, `out` or
+// `inout`.
+//
+// Untagged struct fields are marshalled recursively, fields of type
+// command.Command are added as they are, and values implementing Marshaler
+// produce their own commands. Fields tagged with "-" are skipped, and
+// fields with the `omitempty` option are skipped when they are empty.
+func Marshal(v interface{}) (*Doxygen, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("doxygen: cannot marshal nil pointer")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("doxygen: cannot marshal %s, struct expected", rv.Type())
+	}
+
+	commands, err := marshalStruct(rv)
+	if err != nil {
+		return nil, err
+	}
+	return New(WithMultipleCommands(commands...)), nil
+}
+
+func marshalStruct(rv reflect.Value) ([]command.Command, error) {
+	if cmds, ok, err := marshalSelf(rv); ok {
+		return cmds, err
+	}
+
+	var commands []command.Command
+	typ := rv.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, tagged := field.Tag.Lookup("doxygen")
+		if tag == "-" {
+			continue
+		}
+
+		value := rv.Field(i)
+		if !tagged || tag == "" {
+			cmds, err := marshalUntagged(value)
+			if err != nil {
+				return nil, err
+			}
+			commands = append(commands, cmds...)
+			continue
+		}
+
+		ft, err := parseTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if ft.omitempty && value.IsZero() {
+			continue
+		}
+		if ft.name == "" {
+			ft.name = field.Name
+		}
+
+		cmds, err := marshalField(field.Name, ft, value)
+		if err != nil {
+			return nil, err
+		}
+		commands = append(commands, cmds...)
+	}
+	return commands, nil
+}
+
+func marshalSelf(rv reflect.Value) ([]command.Command, bool, error) {
+	if rv.Type().Implements(marshalerType) {
+		cmds, err := rv.Interface().(Marshaler).MarshalDoxygen()
+		return cmds, true, err
+	}
+	if rv.CanAddr() && rv.Addr().Type().Implements(marshalerType) {
+		cmds, err := rv.Addr().Interface().(Marshaler).MarshalDoxygen()
+		return cmds, true, err
+	}
+	return nil, false, nil
+}
+
+func marshalUntagged(value reflect.Value) ([]command.Command, error) {
+	value = indirect(value)
+	if !value.IsValid() {
+		return nil, nil
+	}
+
+	if value.Type().Implements(commandType) {
+		return []command.Command{value.Interface().(command.Command)}, nil
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		return marshalStruct(value)
+	case reflect.Slice, reflect.Array:
+		var commands []command.Command
+		for i := 0; i < value.Len(); i++ {
+			cmds, err := marshalUntagged(value.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			commands = append(commands, cmds...)
+		}
+		return commands, nil
+	}
+	return nil, nil
+}
+
+func marshalField(name string, ft fieldTag, value reflect.Value) ([]command.Command, error) {
+	value = indirect(value)
+	if !value.IsValid() {
+		return nil, nil
+	}
+	unsupported := UnsupportedTypeError{Field: name, Type: value.Type(), Command: ft.command}
+
+	if fn, ok := textCommands[ft.command]; ok {
+		texts, ok := stringsOf(value)
+		if !ok {
+			return nil, unsupported
+		}
+		var commands []command.Command
+		for _, text := range texts {
+			commands = append(commands, fn(text))
+		}
+		return commands, nil
+	}
+
+	if fn, ok := listCommands[ft.command]; ok {
+		texts, ok := stringsOf(value)
+		if !ok {
+			return nil, unsupported
+		}
+		if len(texts) == 0 {
+			return nil, nil
+		}
+		return []command.Command{fn(texts)}, nil
+	}
+
+	if fn, ok := keyedCommands[ft.command]; ok {
+		return marshalKeyed(fn, ft, value, unsupported)
+	}
+
+	return nil, fmt.Errorf("doxygen: field %s: unsupported command '%s'", name, ft.command)
+}
+
+func marshalKeyed(fn func(name, description, dir string) command.Command, ft fieldTag, value reflect.Value, unsupported error) ([]command.Command, error) {
+	switch value.Kind() {
+	case reflect.String:
+		return []command.Command{fn(ft.name, value.String(), ft.dir)}, nil
+
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String || value.Type().Elem().Kind() != reflect.String {
+			return nil, unsupported
+		}
+		keys := make([]string, 0, value.Len())
+		for _, key := range value.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+
+		var commands []command.Command
+		for _, key := range keys {
+			desc := value.MapIndex(reflect.ValueOf(key).Convert(value.Type().Key()))
+			commands = append(commands, fn(key, desc.String(), ft.dir))
+		}
+		return commands, nil
+
+	case reflect.Struct:
+		cmd, err := marshalKeyedStruct(fn, ft, value, unsupported)
+		if err != nil {
+			return nil, err
+		}
+		return []command.Command{cmd}, nil
+
+	case reflect.Slice, reflect.Array:
+		var commands []command.Command
+		for i := 0; i < value.Len(); i++ {
+			elem := indirect(value.Index(i))
+			if !elem.IsValid() {
+				continue
+			}
+			if elem.Kind() != reflect.Struct {
+				return nil, unsupported
+			}
+			cmd, err := marshalKeyedStruct(fn, ft, elem, unsupported)
+			if err != nil {
+				return nil, err
+			}
+			commands = append(commands, cmd)
+		}
+		return commands, nil
+	}
+	return nil, unsupported
+}
+
+func marshalKeyedStruct(fn func(name, description, dir string) command.Command, ft fieldTag, value reflect.Value, unsupported error) (command.Command, error) {
+	name, description, dir := "", "", ft.dir
+	found := false
+
+	typ := value.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := value.Field(i)
+		if !typ.Field(i).IsExported() || field.Kind() != reflect.String {
+			continue
+		}
+		switch typ.Field(i).Tag.Get("doxygen") {
+		case "name":
+			name, found = field.String(), true
+		case "description":
+			description = field.String()
+		case "dir":
+			if field.String() == "" {
+				continue
+			}
+			d, ok := directions[field.String()]
+			if !ok {
+				return nil, fmt.Errorf("doxygen: invalid direction '%s'", field.String())
+			}
+			dir = d
+		}
+	}
+
+	if !found {
+		return nil, unsupported
+	}
+	return fn(name, description, dir), nil
+}
+
+func stringsOf(value reflect.Value) ([]string, bool) {
+	switch value.Kind() {
+	case reflect.String:
+		return []string{value.String()}, true
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() != reflect.String {
+			return nil, false
+		}
+		texts := make([]string, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			texts = append(texts, value.Index(i).String())
+		}
+		return texts, true
+	}
+	return nil, false
+}
+
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
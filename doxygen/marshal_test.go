@@ -0,0 +1,97 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+type argument struct {
+	Name        string `doxygen:"name"`
+	Description string `doxygen:"description"`
+	Direction   string `doxygen:"dir"`
+}
+
+type status struct {
+	Codes map[string]string `doxygen:"retval,omitempty"`
+}
+
+type function struct {
+	Brief   string     `doxygen:"brief"`
+	Details string     `doxygen:"details,omitempty"`
+	Params  []argument `doxygen:"param"`
+	Flags   string     `doxygen:"param,name=flags,dir=in"`
+	Status  status
+	Returns string   `doxygen:"return"`
+	See     []string `doxygen:"see,omitempty"`
+	Ignored string   `doxygen:"-"`
+}
+
+func TestMarshal(t *testing.T) {
+	d, err := doxygen.Marshal(&function{
+		Brief: "Opens a file.",
+		Params: []argument{
+			{Name: "path", Description: "Path to the file.", Direction: "in"},
+			{Name: "fd", Description: "Opened descriptor.", Direction: "out"},
+		},
+		Flags:   "Open flags.",
+		Status:  status{Codes: map[string]string{"0": "Success.", "-1": "Failure."}},
+		Returns: "Status code.",
+		See:     []string{"close", "read"},
+		Ignored: "ignored",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []command.Command{
+		command.Brief{BriefDescription: "Opens a file."},
+		command.Param{Direction: "in", ParameterName: "path", ParameterDescription: "Path to the file."},
+		command.Param{Direction: "out", ParameterName: "fd", ParameterDescription: "Opened descriptor."},
+		command.Param{Direction: "in", ParameterName: "flags", ParameterDescription: "Open flags."},
+		command.Retval{Name: "-1", Message: "Failure."},
+		command.Retval{Name: "0", Message: "Success."},
+		command.Return{Description: "Status code."},
+		command.See{References: []string{"close", "read"}},
+	}
+	if !reflect.DeepEqual(d.Commands, want) {
+		t.Errorf("unexpected commands:\n%#v", d.Commands)
+	}
+}
+
+func TestMarshalUnsupportedType(t *testing.T) {
+	_, err := doxygen.Marshal(struct {
+		Brief int `doxygen:"brief"`
+	}{})
+	if _, ok := err.(doxygen.UnsupportedTypeError); !ok {
+		t.Errorf("expected unsupported type error, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen
+
+import (
+	"fmt"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+type ErrNotAccessCommand struct {
+	Command string
+}
+
+func (err ErrNotAccessCommand) Error() string {
+	return fmt.Sprintf("'%s' is not an access section command", err.Command)
+}
+
+// Member is a single declaration belonging to a member group. Doc may be nil
+// for undocumented members.
+type Member struct {
+	Doc         *Doxygen
+	Declaration string
+}
+
+// MemberGroup is a named group of members, e.g. all accessors of a class.
+//
+// For more details, see: https://doxygen.nl/manual/grouping.html#memgroup
+type MemberGroup struct {
+	// Tag used in the opening block and group markers, DefaultTag if empty.
+	Tag string
+	// Access is one of Public, Protected, Private, Publicsection,
+	// Protectedsection or Privatesection commands, or nil.
+	Access      command.Command
+	Name        string
+	Description string
+	Members     []Member
+}
+
+// Generate emits the opening block with `name` command, followed by members
+// with their own documentation blocks enclosed in `{` and `}` markers.
+func (g MemberGroup) Generate(out emitter.Emitter) {
+	tag := g.Tag
+	if tag == "" {
+		tag = DefaultTag
+	}
+
+	header := New(WithTag(tag))
+	if g.Access != nil {
+		switch g.Access.(type) {
+		case command.Public, command.Protected, command.Private,
+			command.Publicsection, command.Protectedsection, command.Privatesection:
+			header.Commands = append(header.Commands, g.Access)
+		default:
+			panic(ErrNotAccessCommand{Command: g.Access.Command()})
+		}
+	}
+	header.Commands = append(header.Commands, command.Name{Header: g.Name})
+	if g.Description != "" {
+		header.Commands = append(header.Commands, command.Details{DetailedDescription: g.Description})
+	}
+	header.Generate(out)
+
+	out.Println("//%s{", tag)
+	for _, member := range g.Members {
+		if member.Doc != nil {
+			member.Doc.Generate(out)
+		}
+		out.Println("%s", member.Declaration)
+	}
+	out.Println("//%s}", tag)
+}
@@ -0,0 +1,68 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen_test
+
+import (
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+func TestMemberGroup(t *testing.T) {
+	out := emitter.NewEmitter(100)
+	doxygen.MemberGroup{
+		Tag:         "@",
+		Access:      command.Publicsection{},
+		Name:        "Accessors",
+		Description: "Getters of the widget.",
+		Members: []doxygen.Member{
+			{
+				Doc:         doxygen.Func("width").Brief("Returns width.").MustBuild(),
+				Declaration: "int width() const;",
+			},
+			{Declaration: "int height() const;"},
+		},
+	}.Generate(out)
+
+	want := "/**\n" +
+		"\t@publicsection\n" +
+		"\t@name Accessors\n" +
+		"\t@details Getters of the widget.\n" +
+		"*/\n" +
+		"//@{\n" +
+		"/**\n" +
+		"\t\\brief Returns width.\n" +
+		"*/\n" +
+		"int width() const;\n" +
+		"int height() const;\n" +
+		"//@}\n"
+	if out.String() != want {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
@@ -0,0 +1,150 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen
+
+import (
+	"sort"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+)
+
+// Less reports whether command a should be generated before command b.
+// Commands for which neither is less keep their insertion order.
+type Less func(a, b command.Command) bool
+
+// canonicalGroups lists commands in the canonical order. Commands missing from
+// this list are generated after all of them.
+var canonicalGroups = [...][]string{
+	{
+		"Addtogroup", "Category", "Class", "Concept", "Def", "Defgroup", "Dir",
+		"Enum", "File", "Fn", "Idlexcept", "Interface", "Mainpage", "Namespace",
+		"Overload", "Package", "Page", "Property", "Protocol", "Struct",
+		"Typedef", "Union", "Var", "Weakgroup",
+	},
+	{"Brief", "Short"},
+	{"Details"},
+	{"Param"},
+	{"Tparam"},
+	{"Retval"},
+	{"Return", "Returns", "Result"},
+	{"Exception", "Throw", "Throws"},
+	{"Pre", "Post", "Invariant"},
+	{"Note"},
+	{"Sa", "See"},
+	{"Since"},
+	{"Deprecated"},
+}
+
+// lastRank is the rank of commands missing from canonicalGroups.
+const lastRank = len(canonicalGroups)
+
+var canonicalRanks = func() map[string]int {
+	ranks := map[string]int{}
+	for rank, names := range canonicalGroups {
+		for _, name := range names {
+			ranks[name] = rank
+		}
+	}
+	return ranks
+}()
+
+// inlineCommands are commands printed within the text of the command before
+// them.
+var inlineCommands = map[string]bool{
+	"A": true, "B": true, "C": true, "E": true, "Em": true, "Emoji": true,
+	"Link": true, "N": true, "P": true, "Ref": true, "Text": true,
+}
+
+// CanonicalRank returns position of the command's kind in the canonical order.
+func CanonicalRank(cmd command.Command) int {
+	if rank, ok := canonicalRanks[cmd.Command()]; ok {
+		return rank
+	}
+	return lastRank
+}
+
+// Canonical orders commands as: structural commands, brief, details, params,
+// tparams, retvals, returns, exceptions, pre/post conditions, notes,
+// see-also, since and deprecated. Remaining commands are placed last.
+// Commands of the same kind keep their insertion order, so params stay in
+// the declared order. Inline commands, such as Text or B, move together with
+// the command before them, as with any other ordering.
+func Canonical(a, b command.Command) bool {
+	return CanonicalRank(a) < CanonicalRank(b)
+}
+
+// WithOrdering sets the order in which commands are generated. Commands are
+// sorted stably, without modifying Doxygen.Commands. Inline commands are not
+// compared, they follow the command before them, or the first command when
+// they lead the block.
+func WithOrdering(less Less) Option {
+	return func(d *Doxygen) {
+		d.Order = less
+	}
+}
+
+// order returns indexes of commands in the order they should be generated.
+func (d Doxygen) order() []int {
+	if d.Order == nil {
+		indexes := make([]int, len(d.Commands))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		return indexes
+	}
+
+	// Commands are sorted in runs of a command and inline commands around it.
+	type run struct {
+		head    int
+		indexes []int
+	}
+	var runs []run
+	var leading []int
+	for i, cmd := range d.Commands {
+		switch {
+		case !inlineCommands[cmd.Command()]:
+			runs = append(runs, run{head: i, indexes: append(leading, i)})
+			leading = nil
+		case len(runs) > 0:
+			runs[len(runs)-1].indexes = append(runs[len(runs)-1].indexes, i)
+		default:
+			leading = append(leading, i)
+		}
+	}
+	if len(runs) == 0 {
+		return leading
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return d.Order(d.Commands[runs[i].head], d.Commands[runs[j].head])
+	})
+	indexes := make([]int, 0, len(d.Commands))
+	for _, r := range runs {
+		indexes = append(indexes, r.indexes...)
+	}
+	return indexes
+}
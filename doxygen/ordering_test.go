@@ -0,0 +1,87 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+func TestCanonicalOrdering(t *testing.T) {
+	d := doxygen.New(
+		doxygen.WithOrdering(doxygen.Canonical),
+		doxygen.WithCommand(command.Deprecated{Description: "Use open2."}),
+		doxygen.WithCommand(command.Return{Description: "Descriptor."}),
+		doxygen.WithCommand(command.Param{ParameterName: "path", ParameterDescription: "Path."}),
+		doxygen.WithCommand(command.Brief{BriefDescription: "Opens a file."}),
+		doxygen.WithCommand(command.Param{ParameterName: "flags", ParameterDescription: "Flags."}),
+	)
+
+	sm := emitter.NewSourceMap(emitter.NewEmitter(100))
+	d.Generate(sm)
+	want := "/**\n" +
+		"\t\\brief Opens a file.\n" +
+		"\t\\param path Path.\n" +
+		"\t\\param flags Flags.\n" +
+		"\t\\return Descriptor.\n" +
+		"\t\\deprecated Use open2.\n" +
+		"*/\n"
+	if sm.String() != want {
+		t.Errorf("unexpected output:\n%s", sm.String())
+	}
+	if span, ok := sm.Spans().Lookup(2); !ok || span.Command != 3 {
+		t.Errorf("expected brief to keep its index, got %+v", span)
+	}
+}
+
+func TestCanonicalOrderingInline(t *testing.T) {
+	d := doxygen.New(
+		doxygen.WithOrdering(doxygen.Canonical),
+		doxygen.WithCommand(command.Text{Text: "Leading "}),
+		doxygen.WithCommand(command.Deprecated{Description: "Use"}),
+		doxygen.WithCommand(command.C{Word: "open2"}),
+		doxygen.WithCommand(command.Text{Text: " instead."}),
+		doxygen.WithCommand(command.Brief{BriefDescription: "Opens a file."}),
+		doxygen.WithCommand(command.Param{ParameterName: "path", ParameterDescription: "Path."}),
+		doxygen.WithCommand(command.B{Word: "Must"}),
+		doxygen.WithCommand(command.Text{Text: " exist."}),
+	)
+
+	sm := emitter.NewSourceMap(emitter.NewEmitter(100))
+	d.Generate(sm)
+	var order []int
+	for _, span := range sm.Spans() {
+		order = append(order, span.Command)
+	}
+	if want := []int{4, 5, 6, 7, 0, 1, 2, 3}; !reflect.DeepEqual(order, want) {
+		t.Errorf("got order %v, want %v", order, want)
+	}
+}
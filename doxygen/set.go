@@ -0,0 +1,135 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen
+
+import (
+	"fmt"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+)
+
+// ErrConflict is returned by ErrorOnConflict merge strategy.
+type ErrConflict struct {
+	Identity string
+}
+
+func (err ErrConflict) Error() string {
+	return fmt.Sprintf("conflicting command '%s'", err.Identity)
+}
+
+// MergeStrategy resolves conflict between an existing command and an incoming
+// command with the same identity.
+type MergeStrategy func(existing, incoming command.Command) (command.Command, error)
+
+// KeepExisting resolves conflicts in favor of the existing command.
+func KeepExisting(existing, _ command.Command) (command.Command, error) {
+	return existing, nil
+}
+
+// Overwrite resolves conflicts in favor of the incoming command.
+func Overwrite(_, incoming command.Command) (command.Command, error) {
+	return incoming, nil
+}
+
+// ErrorOnConflict reports every conflict as an error.
+func ErrorOnConflict(existing, _ command.Command) (command.Command, error) {
+	return nil, ErrConflict{Identity: command.Identity(existing)}
+}
+
+func (d Doxygen) index(probe command.Command) int {
+	identity := command.Identity(probe)
+	for i, cmd := range d.Commands {
+		if command.Identity(cmd) == identity {
+			return i
+		}
+	}
+	return -1
+}
+
+// Find returns the first command with the same identity as probe.
+func (d Doxygen) Find(probe command.Command) (command.Command, bool) {
+	if i := d.index(probe); i >= 0 {
+		return d.Commands[i], true
+	}
+	return nil, false
+}
+
+// Upsert replaces the first command with the same identity, or appends the
+// command if there is none.
+func (d *Doxygen) Upsert(cmd command.Command) {
+	if i := d.index(cmd); i >= 0 {
+		d.Commands[i] = cmd
+		return
+	}
+	d.Commands = append(d.Commands, cmd)
+}
+
+// Remove removes all commands with the same identity as probe, and reports
+// whether any was removed.
+func (d *Doxygen) Remove(probe command.Command) bool {
+	identity := command.Identity(probe)
+	commands := d.Commands[:0]
+	for _, cmd := range d.Commands {
+		if command.Identity(cmd) != identity {
+			commands = append(commands, cmd)
+		}
+	}
+	removed := len(commands) != len(d.Commands)
+	d.Commands = commands
+	return removed
+}
+
+// Merge adds commands from other block. Conflicts between commands with the
+// same identity are resolved by the strategy, nil strategy is the same as
+// KeepExisting. If any conflict fails to resolve, the block is left unchanged
+// and errors of all such conflicts are returned together.
+func (d *Doxygen) Merge(other *Doxygen, strategy MergeStrategy) error {
+	if strategy == nil {
+		strategy = KeepExisting
+	}
+
+	merged := Doxygen{Commands: append([]command.Command(nil), d.Commands...)}
+	var errs Errors
+	for _, incoming := range other.Commands {
+		i := merged.index(incoming)
+		if i < 0 {
+			merged.Commands = append(merged.Commands, incoming)
+			continue
+		}
+		resolved, err := strategy(merged.Commands[i], incoming)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		merged.Commands[i] = resolved
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	d.Commands = merged.Commands
+	return nil
+}
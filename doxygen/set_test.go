@@ -0,0 +1,137 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygen_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+func TestWithCommandOnce(t *testing.T) {
+	d := doxygen.New(
+		doxygen.WithCommandOnce(command.Param{ParameterName: "a"}),
+		doxygen.WithCommandOnce(command.Param{ParameterName: "b"}),
+		doxygen.WithCommandOnce(command.Param{ParameterName: "a", ParameterDescription: "Duplicate."}),
+	)
+	if len(d.Commands) != 2 {
+		t.Errorf("expected 2 commands, got %#v", d.Commands)
+	}
+}
+
+func TestWithCommandOnceAlias(t *testing.T) {
+	req := command.AliasDef{Name: "req", Params: 1, Expansion: `\xrefitem reqs "Requirement" "Requirements" \1`}
+	todo := command.AliasDef{Name: "todo2", Expansion: `\todo`}
+	d := doxygen.New(
+		doxygen.WithCommandOnce(command.Alias{Def: req, Args: []string{"R1"}}),
+		doxygen.WithCommandOnce(command.Alias{Def: req, Args: []string{"R2"}}),
+		doxygen.WithCommandOnce(command.Alias{Def: todo}),
+		doxygen.WithCommandOnce(command.Alias{Def: req, Args: []string{"R1"}}),
+	)
+	if len(d.Commands) != 3 {
+		t.Errorf("expected 3 commands, got %#v", d.Commands)
+	}
+}
+
+func TestWithCommandOnceXrefitem(t *testing.T) {
+	d := doxygen.New(
+		doxygen.WithCommandOnce(command.Xrefitem{Name: "reqs", Heading: "Requirement", ListTitle: "Requirements", Text: "R1"}),
+		doxygen.WithCommandOnce(command.Xrefitem{Name: "risks", Heading: "Risk", ListTitle: "Risks", Text: "R1"}),
+		doxygen.WithCommandOnce(command.Xrefitem{Name: "reqs", Heading: "Requirement", ListTitle: "Requirements", Text: "R1"}),
+	)
+	if len(d.Commands) != 2 {
+		t.Errorf("expected 2 commands, got %#v", d.Commands)
+	}
+}
+
+func TestUpsertRemove(t *testing.T) {
+	d := doxygen.New(
+		doxygen.WithCommand(command.Brief{BriefDescription: "Old."}),
+		doxygen.WithCommand(command.Param{ParameterName: "a"}),
+	)
+	d.Upsert(command.Brief{BriefDescription: "New."})
+	d.Upsert(command.Param{ParameterName: "b"})
+
+	if cmd, ok := d.Find(command.Brief{}); !ok || cmd != (command.Brief{BriefDescription: "New."}) {
+		t.Errorf("unexpected brief: %#v", cmd)
+	}
+	if !d.Remove(command.Param{ParameterName: "a"}) || d.Remove(command.Param{ParameterName: "a"}) {
+		t.Error("expected param to be removed exactly once")
+	}
+	if len(d.Commands) != 2 {
+		t.Errorf("expected 2 commands, got %#v", d.Commands)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	base := func() *doxygen.Doxygen {
+		return doxygen.New(
+			doxygen.WithCommand(command.Brief{BriefDescription: "Base."}),
+			doxygen.WithCommand(command.Param{ParameterName: "a"}),
+		)
+	}
+	other := doxygen.New(
+		doxygen.WithCommand(command.Brief{BriefDescription: "Other."}),
+		doxygen.WithCommand(command.Retval{Name: "0", Message: "Success."}),
+	)
+
+	d := base()
+	if err := d.Merge(other, doxygen.Overwrite); err != nil {
+		t.Fatal(err)
+	}
+	want := []command.Command{
+		command.Brief{BriefDescription: "Other."},
+		command.Param{ParameterName: "a"},
+		command.Retval{Name: "0", Message: "Success."},
+	}
+	if !reflect.DeepEqual(d.Commands, want) {
+		t.Errorf("unexpected commands: %#v", d.Commands)
+	}
+
+	d = base()
+	var errs doxygen.Errors
+	if err := d.Merge(other, doxygen.ErrorOnConflict); !errors.As(err, &errs) || len(errs) != 1 {
+		t.Fatalf("expected single conflict, got %v", err)
+	}
+	if _, ok := errs[0].(doxygen.ErrConflict); !ok {
+		t.Errorf("expected conflict, got %v", errs[0])
+	}
+	if !reflect.DeepEqual(d.Commands, base().Commands) {
+		t.Errorf("failed merge modified the block: %#v", d.Commands)
+	}
+
+	d = base()
+	if err := d.Merge(other, nil); err != nil {
+		t.Fatal(err)
+	}
+	if brief, _ := d.Find(command.Brief{}); brief != (command.Brief{BriefDescription: "Base."}) {
+		t.Errorf("nil strategy did not keep existing command: %v", brief)
+	}
+}
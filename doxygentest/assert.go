@@ -0,0 +1,104 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygentest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+	"github.com/shanduur/go-doxygen-generator/internal/diff"
+)
+
+// MaxLineLength is line length of the emitter used by the helpers.
+const MaxLineLength = 100
+
+var update = flag.Bool("update-golden", false, "update golden files used by doxygentest")
+
+// Render returns output of a single command.
+func Render(cmd command.Command, tag string) string {
+	out := emitter.NewEmitter(MaxLineLength)
+	cmd.Generate(tag, out)
+	return out.String()
+}
+
+// Diff returns line based diff between want and got, or empty string if they
+// are equal.
+func Diff(want, got string) string {
+	return diff.Lines(want, got)
+}
+
+// DiffIgnoreSpace works like Diff, but ignores differences in whitespace and
+// blank lines.
+func DiffIgnoreSpace(want, got string) string {
+	return diff.LinesIgnoreSpace(want, got)
+}
+
+// AssertRenders checks that the command renders exactly to want.
+func AssertRenders(t testing.TB, cmd command.Command, tag, want string) {
+	t.Helper()
+	if d := Diff(want, Render(cmd, tag)); d != "" {
+		t.Errorf("%s rendered unexpected output (-want +got):\n%s", cmd.Command(), d)
+	}
+}
+
+// AssertRendersIgnoreSpace checks that the command renders to want, ignoring
+// differences in whitespace.
+func AssertRendersIgnoreSpace(t testing.TB, cmd command.Command, tag, want string) {
+	t.Helper()
+	if d := DiffIgnoreSpace(want, Render(cmd, tag)); d != "" {
+		t.Errorf("%s rendered unexpected output (-want +got):\n%s", cmd.Command(), d)
+	}
+}
+
+// AssertGolden compares got with the contents of testdata/<name>.golden.
+// When tests are run with -update-golden flag, the file is overwritten instead.
+func AssertGolden(t testing.TB, name string, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read golden file: %v", err)
+	}
+	if d := Diff(string(want), got); d != "" {
+		t.Errorf("output differs from %s (-want +got):\n%s", path, d)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygentest_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygentest"
+)
+
+func TestRecorder(t *testing.T) {
+	rec := doxygentest.NewRecorder()
+	command.Brief{BriefDescription: "Opens a file."}.Generate(`\`, rec)
+
+	if len(rec.Events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(rec.Events))
+	}
+	if ev := rec.Events[0]; ev.Kind != doxygentest.PrintlnEvent || ev.Text() != `\brief Opens a file.` {
+		t.Errorf("unexpected event: %s", ev)
+	}
+}
+
+func TestAssertRenders(t *testing.T) {
+	doxygentest.AssertRenders(t, command.Brief{BriefDescription: "Opens a file."}, `\`, "\\brief Opens a file.\n")
+	doxygentest.AssertRendersIgnoreSpace(t, command.Brief{BriefDescription: "Opens a file."}, `@`, "  @brief   Opens a file.")
+}
+
+func TestDiffIgnoreSpace(t *testing.T) {
+	if d := doxygentest.DiffIgnoreSpace("a  b\n\nc", " a b\nc\n"); d != "" {
+		t.Errorf("expected no difference, got:\n%s", d)
+	}
+	if d := doxygentest.Diff("a\nb", "a\nc"); d != " a\n-b\n+c\n" {
+		t.Errorf("unexpected diff:\n%s", d)
+	}
+}
+
+// failures records failures reported by assertions under test.
+type failures struct {
+	testing.TB
+	messages []string
+}
+
+func (f *failures) Helper() {}
+
+func (f *failures) Errorf(format string, args ...interface{}) {
+	f.messages = append(f.messages, fmt.Sprintf(format, args...))
+}
+
+func (f *failures) Fatalf(format string, args ...interface{}) {
+	f.messages = append(f.messages, fmt.Sprintf(format, args...))
+}
+
+func TestAssertGolden(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	if err := flag.Set("update-golden", "true"); err != nil {
+		t.Fatal(err)
+	}
+	doxygentest.AssertGolden(t, "brief", "\\brief Opens a file.\n")
+	if err := flag.Set("update-golden", "false"); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := os.ReadFile(filepath.Join("testdata", "brief.golden")); err != nil || string(data) != "\\brief Opens a file.\n" {
+		t.Fatalf("golden file not updated: %q, %v", data, err)
+	}
+
+	doxygentest.AssertGolden(t, "brief", "\\brief Opens a file.\n")
+
+	f := &failures{TB: t}
+	doxygentest.AssertGolden(f, "brief", "\\brief Closes a file.\n")
+	if len(f.messages) != 1 || !strings.Contains(f.messages[0], "-\\brief Opens a file.\n+\\brief Closes a file.") {
+		t.Errorf("unexpected failures: %q", f.messages)
+	}
+}
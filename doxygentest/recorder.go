@@ -0,0 +1,147 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package doxygentest
+
+import (
+	"fmt"
+
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+type EventKind int
+
+const (
+	IndentEvent EventKind = iota
+	PrintEvent
+	PrintlnEvent
+	NewlineEvent
+	RawEvent
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case IndentEvent:
+		return "Indent"
+	case PrintEvent:
+		return "Print"
+	case PrintlnEvent:
+		return "Println"
+	case NewlineEvent:
+		return "Newline"
+	case RawEvent:
+		return "PrintRaw"
+	}
+	return fmt.Sprintf("EventKind(%d)", int(k))
+}
+
+// Event is a single call made on the Recorder. N is set only for Indent
+// events, Format and Args only for Print and Println events, and Format holds
+// the text of PrintRaw events.
+type Event struct {
+	Kind   EventKind
+	N      int
+	Format string
+	Args   []interface{}
+}
+
+// Text returns formatted text of Print and Println events.
+func (ev Event) Text() string {
+	switch ev.Kind {
+	case PrintEvent, PrintlnEvent:
+		return fmt.Sprintf(ev.Format, ev.Args...)
+	case RawEvent:
+		return ev.Format
+	}
+	return ""
+}
+
+func (ev Event) String() string {
+	switch ev.Kind {
+	case IndentEvent:
+		return fmt.Sprintf("Indent(%d)", ev.N)
+	case PrintEvent, PrintlnEvent, RawEvent:
+		return fmt.Sprintf("%s(%q)", ev.Kind, ev.Text())
+	}
+	return ev.Kind.String() + "()"
+}
+
+// Recorder is an emitter capturing the sequence of calls made on it.
+type Recorder struct {
+	Events []Event
+}
+
+var (
+	_ emitter.Emitter = (*Recorder)(nil)
+	_ emitter.Raw     = (*Recorder)(nil)
+)
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) Indent(n int) {
+	r.Events = append(r.Events, Event{Kind: IndentEvent, N: n})
+}
+
+func (r *Recorder) Print(format string, args ...interface{}) {
+	r.Events = append(r.Events, Event{Kind: PrintEvent, Format: format, Args: args})
+}
+
+func (r *Recorder) Println(format string, args ...interface{}) {
+	r.Events = append(r.Events, Event{Kind: PrintlnEvent, Format: format, Args: args})
+}
+
+func (r *Recorder) Newline() {
+	r.Events = append(r.Events, Event{Kind: NewlineEvent})
+}
+
+func (r *Recorder) PrintRaw(text string) {
+	r.Events = append(r.Events, Event{Kind: RawEvent, Format: text})
+}
+
+// Replay repeats all recorded calls on the given emitter.
+func (r *Recorder) Replay(out emitter.Emitter) {
+	for _, ev := range r.Events {
+		switch ev.Kind {
+		case IndentEvent:
+			out.Indent(ev.N)
+		case PrintEvent:
+			out.Print(ev.Format, ev.Args...)
+		case PrintlnEvent:
+			out.Println(ev.Format, ev.Args...)
+		case NewlineEvent:
+			out.Newline()
+		case RawEvent:
+			emitter.PrintRaw(out, ev.Format)
+		}
+	}
+}
+
+// Reset removes all recorded events.
+func (r *Recorder) Reset() {
+	r.Events = nil
+}
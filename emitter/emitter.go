@@ -40,6 +40,35 @@ type Emitter interface {
 	Newline()
 }
 
+// Raw is implemented by emitters able to print text exactly as given, without
+// indentation and formatting.
+type Raw interface {
+	PrintRaw(text string)
+}
+
+// PrintRaw prints text exactly as given if the emitter implements Raw.
+// Otherwise every line is printed separately, so it gets indented.
+func PrintRaw(out Emitter, text string) {
+	if r, ok := out.(Raw); ok {
+		r.PrintRaw(text)
+		return
+	}
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			break
+		}
+		if strings.HasSuffix(line, "\n") {
+			if line == "\n" {
+				out.Newline()
+			} else {
+				out.Println("%s", strings.TrimSuffix(line, "\n"))
+			}
+			continue
+		}
+		out.Print("%s", line)
+	}
+}
+
 type SampleEmitter struct {
 	sb            strings.Builder
 	maxLineLength uint
@@ -90,6 +119,14 @@ func (e *SampleEmitter) Println(format string, args ...interface{}) {
 	e.Newline()
 }
 
+func (e *SampleEmitter) PrintRaw(text string) {
+	if text == "" {
+		return
+	}
+	e.sb.WriteString(text)
+	e.start = strings.HasSuffix(text, "\n")
+}
+
 func (e *SampleEmitter) Newline() {
 	e.sb.WriteRune('\n')
 	e.start = true
@@ -0,0 +1,174 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package emitter
+
+import "sort"
+
+// CommandTracker is implemented by emitters that want to be notified when
+// the output of a single command starts and ends.
+type CommandTracker interface {
+	BeginCommand(index int)
+	EndCommand(index int)
+}
+
+// Buffer is an Emitter that exposes everything emitted so far.
+type Buffer interface {
+	Emitter
+	String() string
+}
+
+// Position is a location in the emitted output. Offset is zero-based and
+// counted in bytes, Line and Column are one-based.
+type Position struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+// Span is the part of the output produced by a single command. Command is the
+// index of the command in Doxygen.Commands, End is exclusive.
+type Span struct {
+	Command int
+	Start   Position
+	End     Position
+}
+
+// Lines returns the first and the last output line covered by the span.
+func (s Span) Lines() (first, last int) {
+	last = s.End.Line
+	if s.End.Column == 1 && s.End.Offset > s.Start.Offset {
+		last--
+	}
+	return s.Start.Line, last
+}
+
+// Contains reports whether the span covers the given output line.
+func (s Span) Contains(line int) bool {
+	first, last := s.Lines()
+	return line >= first && line <= last
+}
+
+// SpanTable is a list of spans ordered by their start offset.
+type SpanTable []Span
+
+// Command returns the span produced by the command with given index.
+func (t SpanTable) Command(index int) (Span, bool) {
+	for _, s := range t {
+		if s.Command == index {
+			return s, true
+		}
+	}
+	return Span{}, false
+}
+
+// Lookup returns the first span covering the given output line.
+func (t SpanTable) Lookup(line int) (Span, bool) {
+	for _, s := range t {
+		if s.Contains(line) {
+			return s, true
+		}
+	}
+	return Span{}, false
+}
+
+// LookupAll returns all spans covering the given output line. More than one
+// span is returned when inline commands share a line.
+func (t SpanTable) LookupAll(line int) SpanTable {
+	var spans SpanTable
+	for _, s := range t {
+		if s.Contains(line) {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+// SourceMap is an emitter decorator recording which part of the output was
+// produced by which command.
+type SourceMap struct {
+	Buffer
+	spans SpanTable
+	open  []Span
+	pos   Position
+}
+
+func NewSourceMap(out Buffer) *SourceMap {
+	sm := &SourceMap{
+		Buffer: out,
+		pos:    Position{Line: 1, Column: 1},
+	}
+	sm.position()
+	return sm
+}
+
+func (sm *SourceMap) BeginCommand(index int) {
+	sm.open = append(sm.open, Span{
+		Command: index,
+		Start:   sm.position(),
+	})
+}
+
+func (sm *SourceMap) EndCommand(index int) {
+	for i := len(sm.open) - 1; i >= 0; i-- {
+		if sm.open[i].Command != index {
+			continue
+		}
+		span := sm.open[i]
+		span.End = sm.position()
+		sm.open = append(sm.open[:i], sm.open[i+1:]...)
+		sm.spans = append(sm.spans, span)
+		return
+	}
+	panic("unexpected end of command that was never started")
+}
+
+func (sm *SourceMap) PrintRaw(text string) {
+	PrintRaw(sm.Buffer, text)
+}
+
+// Spans returns recorded spans ordered by their start offset.
+func (sm *SourceMap) Spans() SpanTable {
+	spans := make(SpanTable, len(sm.spans))
+	copy(spans, sm.spans)
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].Start.Offset < spans[j].Start.Offset
+	})
+	return spans
+}
+
+func (sm *SourceMap) position() Position {
+	s := sm.Buffer.String()
+	for ; sm.pos.Offset < len(s); sm.pos.Offset++ {
+		if s[sm.pos.Offset] == '\n' {
+			sm.pos.Line++
+			sm.pos.Column = 1
+		} else {
+			sm.pos.Column++
+		}
+	}
+	return sm.pos
+}
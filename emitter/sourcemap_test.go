@@ -0,0 +1,59 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package emitter_test
+
+import (
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+func TestSourceMap(t *testing.T) {
+	sm := emitter.NewSourceMap(emitter.NewEmitter(100))
+	doxygen.New(
+		doxygen.WithCommand(command.Brief{BriefDescription: "Opens a file."}),
+		doxygen.WithCommand(command.Param{ParameterName: "path", ParameterDescription: "Path to the file."}),
+	).Generate(sm)
+
+	spans := sm.Spans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	span, ok := spans.Lookup(3)
+	if !ok || span.Command != 1 {
+		t.Fatalf("expected line 3 to map to command 1, got %+v", span)
+	}
+	if span.Start.Line != 3 || span.Start.Column != 1 || span.End.Line != 4 {
+		t.Errorf("unexpected span position: %+v", span)
+	}
+	if _, ok := spans.Lookup(1); ok {
+		t.Error("expected no command on the opening line")
+	}
+}
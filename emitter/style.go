@@ -0,0 +1,68 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package emitter
+
+// Styler is implemented by emitters carrying the mode in which inline
+// commands should be rendered.
+type Styler interface {
+	InlineMode() string
+}
+
+// Styled wraps the emitter, so that it reports given inline mode. Optional
+// interfaces of the wrapped emitter are forwarded.
+type Styled struct {
+	Emitter
+	Mode string
+}
+
+// WithInlineMode returns emitter reporting given inline mode.
+func WithInlineMode(out Emitter, mode string) *Styled {
+	if s, ok := out.(*Styled); ok {
+		out = s.Emitter
+	}
+	return &Styled{Emitter: out, Mode: mode}
+}
+
+func (s *Styled) InlineMode() string {
+	return s.Mode
+}
+
+func (s *Styled) BeginCommand(index int) {
+	if tracker, ok := s.Emitter.(CommandTracker); ok {
+		tracker.BeginCommand(index)
+	}
+}
+
+func (s *Styled) EndCommand(index int) {
+	if tracker, ok := s.Emitter.(CommandTracker); ok {
+		tracker.EndCommand(index)
+	}
+}
+
+func (s *Styled) PrintRaw(text string) {
+	PrintRaw(s.Emitter, text)
+}
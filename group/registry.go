@@ -0,0 +1,310 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package group
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+)
+
+type ErrUndefinedGroup struct {
+	Name string
+}
+
+func (err ErrUndefinedGroup) Error() string {
+	return fmt.Sprintf("group '%s' is referenced, but never defined", err.Name)
+}
+
+type ErrRedefinedGroup struct {
+	Name   string
+	Titles []string
+}
+
+func (err ErrRedefinedGroup) Error() string {
+	return fmt.Sprintf("group '%s' is defined with different titles: %s", err.Name, strings.Join(err.Titles, ", "))
+}
+
+type ErrDuplicateTitle struct {
+	Title  string
+	Groups []string
+}
+
+func (err ErrDuplicateTitle) Error() string {
+	return fmt.Sprintf("title '%s' is used by many groups: %s", err.Title, strings.Join(err.Groups, ", "))
+}
+
+type ErrCycle struct {
+	Path []string
+}
+
+func (err ErrCycle) Error() string {
+	return fmt.Sprintf("group hierarchy contains a cycle: %s", strings.Join(err.Path, " -> "))
+}
+
+// Group collects everything known about a single group.
+type Group struct {
+	Name string
+	// Title is taken from the strongest definition, in order: Defgroup,
+	// Addtogroup and Weakgroup.
+	Title string
+	// Titles lists all distinct titles given in Defgroup commands.
+	Titles []string
+	// Parents are groups this group belongs to.
+	Parents []string
+	// Members are names of entities other than groups belonging to the group.
+	Members []string
+	Defined bool
+}
+
+// Registry collects group definitions and memberships from many blocks.
+type Registry struct {
+	Tag      string
+	groups   map[string]*Group
+	strength map[string]int
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		Tag:      doxygen.DefaultTag,
+		groups:   map[string]*Group{},
+		strength: map[string]int{},
+	}
+}
+
+func (r *Registry) group(name string) *Group {
+	g, ok := r.groups[name]
+	if !ok {
+		g = &Group{Name: name}
+		r.groups[name] = g
+	}
+	return g
+}
+
+func (r *Registry) define(name, title string, strength int) {
+	g := r.group(name)
+	g.Defined = true
+	if strength == defgroupStrength && !contains(g.Titles, title) {
+		g.Titles = append(g.Titles, title)
+	}
+	if title != "" && strength > r.strength[name] {
+		g.Title = title
+		r.strength[name] = strength
+	}
+}
+
+const (
+	weakgroupStrength = iota + 1
+	addtogroupStrength
+	defgroupStrength
+)
+
+// Add collects groups defined in the block and its group memberships. Groups
+// named in Ingroup become parents of groups defined in the same block.
+// Otherwise, the entity documented by the block becomes their member.
+func (r *Registry) Add(d *doxygen.Doxygen) {
+	var defined, parents []string
+	entity := ""
+
+	for _, cmd := range d.Commands {
+		switch cmd := cmd.(type) {
+		case command.Defgroup:
+			r.define(cmd.Name, cmd.GroupTitle, defgroupStrength)
+			defined = append(defined, cmd.Name)
+		case command.Addtogroup:
+			r.define(cmd.Name, cmd.Title, addtogroupStrength)
+			defined = append(defined, cmd.Name)
+		case command.Weakgroup:
+			r.define(cmd.Name, cmd.Title, weakgroupStrength)
+			defined = append(defined, cmd.Name)
+		case command.Ingroup:
+			parents = append(parents, cmd.Groups...)
+		default:
+			kind, name, ok := command.Declares(cmd)
+			if ok && entity == "" && kind != command.KindAnchor && kind != command.KindSection {
+				entity = name
+			}
+		}
+	}
+
+	for _, parent := range parents {
+		r.group(parent)
+		if len(defined) == 0 {
+			if entity != "" && !contains(r.groups[parent].Members, entity) {
+				r.groups[parent].Members = append(r.groups[parent].Members, entity)
+			}
+			continue
+		}
+		for _, name := range defined {
+			if !contains(r.groups[name].Parents, parent) {
+				r.groups[name].Parents = append(r.groups[name].Parents, parent)
+			}
+		}
+	}
+}
+
+// Groups returns all known groups sorted by name.
+func (r *Registry) Groups() []Group {
+	groups := make([]Group, 0, len(r.groups))
+	for _, name := range r.names() {
+		groups = append(groups, *r.groups[name])
+	}
+	return groups
+}
+
+// Lookup returns the named group.
+func (r *Registry) Lookup(name string) (Group, bool) {
+	g, ok := r.groups[name]
+	if !ok {
+		return Group{}, false
+	}
+	return *g, true
+}
+
+func (r *Registry) names() []string {
+	names := make([]string, 0, len(r.groups))
+	for name := range r.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks the hierarchy for undefined groups, groups defined with
+// different titles, titles shared by many groups and cycles.
+func (r *Registry) Validate() error {
+	var errs doxygen.Errors
+	titles := map[string][]string{}
+
+	for _, name := range r.names() {
+		g := r.groups[name]
+		if !g.Defined {
+			errs = append(errs, ErrUndefinedGroup{Name: name})
+		}
+		if len(g.Titles) > 1 {
+			errs = append(errs, ErrRedefinedGroup{Name: name, Titles: g.Titles})
+		}
+		if g.Title != "" {
+			titles[g.Title] = append(titles[g.Title], name)
+		}
+	}
+
+	var duplicated []string
+	for title, names := range titles {
+		if len(names) > 1 {
+			duplicated = append(duplicated, title)
+		}
+	}
+	sort.Strings(duplicated)
+	for _, title := range duplicated {
+		errs = append(errs, ErrDuplicateTitle{Title: title, Groups: titles[title]})
+	}
+
+	for _, cycle := range r.cycles() {
+		errs = append(errs, ErrCycle{Path: cycle})
+	}
+	return errs.Err()
+}
+
+func (r *Registry) cycles() [][]string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var cycles [][]string
+	var path []string
+
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		path = append(path, name)
+		for _, parent := range r.groups[name].Parents {
+			switch state[parent] {
+			case unvisited:
+				visit(parent)
+			case visiting:
+				for i := range path {
+					if path[i] == parent {
+						cycle := append([]string{}, path[i:]...)
+						cycles = append(cycles, append(cycle, parent))
+						break
+					}
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+	}
+
+	for _, name := range r.names() {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+	return cycles
+}
+
+// Generate emits consolidated group definitions, suitable for `groups.dox`
+// file. Every group gets its own block with `defgroup` command, followed by
+// `ingroup` command listing its parents. Groups without title, including
+// the ones referenced but never defined, are titled with their name.
+func (r *Registry) Generate(out emitter.Emitter) {
+	for i, g := range r.Groups() {
+		if i > 0 {
+			out.Newline()
+		}
+		title := g.Title
+		if title == "" {
+			title = g.Name
+		}
+		d := doxygen.New(
+			doxygen.WithTag(r.Tag),
+			doxygen.WithCommand(command.Defgroup{Name: g.Name, GroupTitle: title}),
+		)
+		if len(g.Parents) > 0 {
+			parents := append([]string{}, g.Parents...)
+			sort.Strings(parents)
+			d.Commands = append(d.Commands, command.Ingroup{Groups: parents})
+		}
+		d.Generate(out)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
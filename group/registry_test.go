@@ -0,0 +1,110 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package group_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+	"github.com/shanduur/go-doxygen-generator/group"
+)
+
+func TestRegistry(t *testing.T) {
+	r := group.NewRegistry()
+	r.Add(doxygen.Group("io", "Input and output").MustBuild())
+	r.Add(doxygen.New(
+		doxygen.WithCommand(command.Defgroup{Name: "files", GroupTitle: "Files"}),
+		doxygen.WithCommand(command.Ingroup{Groups: []string{"io"}}),
+	))
+	r.Add(doxygen.New(
+		doxygen.WithCommand(command.Fn{Name: "open"}),
+		doxygen.WithCommand(command.Ingroup{Groups: []string{"files"}}),
+	))
+
+	if err := r.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := r.Lookup("files"); len(g.Members) != 1 || g.Members[0] != "open" {
+		t.Errorf("unexpected members: %v", g.Members)
+	}
+
+	out := emitter.NewEmitter(100)
+	r.Generate(out)
+	want := "/**\n" +
+		"\t\\defgroup files Files\n" +
+		"\t\\ingroup io\n" +
+		"*/\n" +
+		"\n" +
+		"/**\n" +
+		"\t\\defgroup io Input and output\n" +
+		"*/\n"
+	if out.String() != want {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+
+	r.Add(doxygen.New(doxygen.WithCommand(command.Ingroup{Groups: []string{"undefined"}})))
+	out = emitter.NewEmitter(100)
+	r.Generate(out)
+	if !strings.Contains(out.String(), "\t\\defgroup undefined undefined\n") {
+		t.Errorf("undefined group not titled with its name:\n%s", out.String())
+	}
+}
+
+func TestRegistryValidate(t *testing.T) {
+	r := group.NewRegistry()
+	r.Add(doxygen.New(
+		doxygen.WithCommand(command.Defgroup{Name: "a", GroupTitle: "Same"}),
+		doxygen.WithCommand(command.Ingroup{Groups: []string{"b"}}),
+	))
+	r.Add(doxygen.New(
+		doxygen.WithCommand(command.Defgroup{Name: "b", GroupTitle: "Same"}),
+		doxygen.WithCommand(command.Ingroup{Groups: []string{"a", "missing"}}),
+	))
+
+	var errs doxygen.Errors
+	if !errors.As(r.Validate(), &errs) {
+		t.Fatal("expected validation errors")
+	}
+	var undefined, titles, cycles int
+	for _, err := range errs {
+		switch err.(type) {
+		case group.ErrUndefinedGroup:
+			undefined++
+		case group.ErrDuplicateTitle:
+			titles++
+		case group.ErrCycle:
+			cycles++
+		}
+	}
+	if undefined != 1 || titles != 1 || cycles != 1 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
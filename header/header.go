@@ -0,0 +1,260 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+// Package header inserts and refreshes documentation blocks above
+// declarations in C and C++ headers.
+package header
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/cdecl"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/emitter"
+	"github.com/shanduur/go-doxygen-generator/internal/diff"
+)
+
+// MaxLineLength is line length of the emitter generating the blocks.
+const MaxLineLength = 100
+
+type ErrUndeclared struct {
+	Name string
+}
+
+func (err ErrUndeclared) Error() string {
+	return fmt.Sprintf("declaration of '%s' not found", err.Name)
+}
+
+type ErrSharedLine struct {
+	Name string
+	Line int
+}
+
+func (err ErrSharedLine) Error() string {
+	return fmt.Sprintf("declaration of '%s' at line %d shares the line with documented declaration", err.Name, err.Line)
+}
+
+// edit replaces source between start and end with text.
+type edit struct {
+	start int
+	end   int
+	text  string
+}
+
+// Update returns the source with the block of every named declaration placed
+// directly above its first declaration. Existing `/**`, `/*!`, `///` and `//!`
+// blocks are replaced, other comments are kept. The generated blocks use the
+// indentation of the declaration and line endings of the source, the rest of
+// the source is preserved byte for byte.
+//
+// Names which are not declared in the source are reported as
+// doxygen.Errors of ErrUndeclared, and declarations sharing the line with
+// another documented declaration as ErrSharedLine, together with the updated
+// source. Only the first declaration on the line gets its block.
+func Update(src []byte, docs map[string]*doxygen.Doxygen) ([]byte, error) {
+	decls, err := cdecl.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	text := string(src)
+	newline := "\n"
+	if strings.Count(text, "\r\n")*2 > strings.Count(text, "\n") {
+		newline = "\r\n"
+	}
+
+	var edits []edit
+	var errs doxygen.Errors
+	seen := map[string]bool{}
+	for _, decl := range decls {
+		d, ok := docs[decl.Name]
+		if !ok || seen[decl.Name] {
+			continue
+		}
+		seen[decl.Name] = true
+
+		start := strings.LastIndex(text[:decl.Start], "\n") + 1
+		if len(edits) > 0 && edits[len(edits)-1].end == start {
+			errs = append(errs, ErrSharedLine{Name: decl.Name, Line: decl.Line})
+			continue
+		}
+		indent := text[start : start+len(text[start:])-len(strings.TrimLeft(text[start:], " \t"))]
+		edits = append(edits, edit{
+			start: docStart(text, start),
+			end:   start,
+			text:  render(d, indent, newline),
+		})
+	}
+
+	for _, name := range sortedNames(docs) {
+		if !seen[name] {
+			errs = append(errs, ErrUndeclared{Name: name})
+		}
+	}
+
+	sb := strings.Builder{}
+	last := 0
+	for _, e := range edits {
+		sb.WriteString(text[last:e.start])
+		sb.WriteString(e.text)
+		last = e.end
+	}
+	sb.WriteString(text[last:])
+	return []byte(sb.String()), errs.Err()
+}
+
+// Diff returns line based diff between the source and its updated version,
+// without modifying anything.
+func Diff(src []byte, docs map[string]*doxygen.Doxygen) (string, error) {
+	out, err := Update(src, docs)
+	if out == nil {
+		return "", err
+	}
+	return diff.Lines(string(src), string(out)), err
+}
+
+// UpdateFile updates the header in place. In dry run the file is left intact.
+// In both cases the diff of the changes is returned.
+func UpdateFile(path string, docs map[string]*doxygen.Doxygen, dryRun bool) (string, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	out, err := Update(src, docs)
+	if out == nil {
+		return "", err
+	}
+	if !dryRun && string(out) != string(src) {
+		info, statErr := os.Stat(path)
+		if statErr != nil {
+			return "", statErr
+		}
+		if writeErr := os.WriteFile(path, out, info.Mode().Perm()); writeErr != nil {
+			return "", writeErr
+		}
+	}
+	return diff.Lines(string(src), string(out)), err
+}
+
+// render returns the block with every line indented and terminated by the
+// newline. Lines within the block are continued with ` * ` instead of the
+// indentation of the emitter, raw lines are kept as they are.
+func render(d *doxygen.Doxygen, indent, newline string) string {
+	out := &blockEmitter{SampleEmitter: emitter.NewEmitter(MaxLineLength), raw: map[int]bool{}}
+	d.Generate(out)
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	sb := strings.Builder{}
+	for i, line := range lines {
+		sb.WriteString(indent)
+		if !out.raw[i] {
+			line = strings.TrimPrefix(line, "\t")
+		}
+		switch {
+		case i == 0:
+			sb.WriteString(line)
+		case i == len(lines)-1:
+			sb.WriteString(" " + line)
+		case line == "":
+			sb.WriteString(" *")
+		default:
+			sb.WriteString(" * " + line)
+		}
+		sb.WriteString(newline)
+	}
+	return sb.String()
+}
+
+// blockEmitter records lines printed raw, which are not indented by the
+// emitter.
+type blockEmitter struct {
+	*emitter.SampleEmitter
+	raw map[int]bool
+}
+
+func (e *blockEmitter) PrintRaw(text string) {
+	printed := e.String()
+	line := strings.Count(printed, "\n")
+	atStart := printed == "" || strings.HasSuffix(printed, "\n")
+	for i, l := range strings.SplitAfter(text, "\n") {
+		if l != "" && (i > 0 || atStart) {
+			e.raw[line+i] = true
+		}
+	}
+	e.SampleEmitter.PrintRaw(text)
+}
+
+// docStart returns offset of the documentation block ending on the line
+// before the one starting at offset, or the offset when there is none.
+func docStart(text string, offset int) int {
+	lines := strings.SplitAfter(text[:offset], "\n")
+	lines = lines[:len(lines)-1]
+
+	start := offset
+	last := len(lines) - 1
+	if last < 0 {
+		return offset
+	}
+
+	trimmed := strings.TrimSpace(lines[last])
+	if strings.HasPrefix(trimmed, "///") || strings.HasPrefix(trimmed, "//!") {
+		for i := last; i >= 0; i-- {
+			t := strings.TrimSpace(lines[i])
+			if !strings.HasPrefix(t, "///") && !strings.HasPrefix(t, "//!") {
+				break
+			}
+			start -= len(lines[i])
+		}
+		return start
+	}
+
+	if !strings.HasSuffix(trimmed, "*/") {
+		return offset
+	}
+	for i := last; i >= 0; i-- {
+		start -= len(lines[i])
+		t := strings.TrimSpace(lines[i])
+		switch {
+		case strings.HasPrefix(t, "/**") || strings.HasPrefix(t, "/*!"):
+			return start
+		case strings.Contains(t, "/*"), i != last && strings.Contains(t, "*/"):
+			return offset
+		}
+	}
+	return offset
+}
+
+func sortedNames(docs map[string]*doxygen.Doxygen) []string {
+	names := make([]string, 0, len(docs))
+	for name := range docs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
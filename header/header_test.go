@@ -0,0 +1,163 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package header_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/header"
+)
+
+const src = `#include <stdio.h>
+
+extern "C" {
+    /* Plain comment. */
+    int api_open(const char *path);
+
+    /**
+     * @brief Outdated.
+     */
+    void api_close(int fd);
+}
+`
+
+func docs() map[string]*doxygen.Doxygen {
+	return map[string]*doxygen.Doxygen{
+		"api_open": doxygen.New(doxygen.WithMultipleCommands(
+			command.Brief{BriefDescription: "Opens a file."},
+			command.Param{Direction: "in", ParameterName: "path", ParameterDescription: "Path of the file."},
+		)),
+		"api_close": doxygen.New(doxygen.WithCommand(command.Brief{BriefDescription: "Closes a file."})),
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	want := `#include <stdio.h>
+
+extern "C" {
+    /* Plain comment. */
+    /**
+     * \brief Opens a file.
+     * \param[in] path Path of the file.
+     */
+    int api_open(const char *path);
+
+    /**
+     * \brief Closes a file.
+     */
+    void api_close(int fd);
+}
+`
+	out, err := header.Update([]byte(src), docs())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out, want)
+	}
+
+	again, err := header.Update(out, docs())
+	if err != nil || string(again) != string(out) {
+		t.Errorf("update is not idempotent: %v\n%s", err, again)
+	}
+
+	crlf := strings.ReplaceAll(src, "\n", "\r\n")
+	out, err = header.Update([]byte(crlf), docs())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != strings.ReplaceAll(want, "\n", "\r\n") {
+		t.Errorf("line endings not preserved:\n%q", out)
+	}
+}
+
+func TestUpdateSharedLine(t *testing.T) {
+	d := map[string]*doxygen.Doxygen{
+		"a": doxygen.New(doxygen.WithCommand(command.Brief{BriefDescription: "First."})),
+		"b": doxygen.New(doxygen.WithCommand(command.Brief{BriefDescription: "Second."})),
+	}
+	out, err := header.Update([]byte("/** old */\nvoid a(void); void b(void);\n"), d)
+	var errs doxygen.Errors
+	if !errors.As(err, &errs) || len(errs) != 1 || !errors.As(errs[0], &header.ErrSharedLine{}) {
+		t.Errorf("expected shared line error, got %v", err)
+	}
+	want := "/**\n * \\brief First.\n */\nvoid a(void); void b(void);\n"
+	if string(out) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestUpdateCode(t *testing.T) {
+	d := map[string]*doxygen.Doxygen{
+		"f": doxygen.New(doxygen.WithMultipleCommands(
+			command.Brief{BriefDescription: "Returns one."},
+			command.Code{CodeBlock: "if (x) {\n\treturn 1;   \n}"},
+		)),
+	}
+	out, err := header.Update([]byte("\tint f(int x);\n"), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "\t/**\n\t * \\brief Returns one.\n\t * \\code\n\t * if (x) {\n\t * \treturn 1;   \n\t * }\n\t * \\endcode\n\t */\n\tint f(int x);\n"
+	if string(out) != want {
+		t.Errorf("got:\n%q\nwant:\n%q", out, want)
+	}
+}
+
+func TestUpdateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api.h")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := docs()
+	d["api_missing"] = doxygen.New()
+	patch, err := header.UpdateFile(path, d, true)
+	var errs doxygen.Errors
+	if !errors.As(err, &errs) || len(errs) != 1 {
+		t.Errorf("expected undeclared error, got %v", err)
+	}
+	if !strings.Contains(patch, "-     * @brief Outdated.") || !strings.Contains(patch, "+     * \\brief Opens a file.") {
+		t.Errorf("unexpected diff:\n%s", patch)
+	}
+	if data, _ := os.ReadFile(path); string(data) != src {
+		t.Error("dry run modified the file")
+	}
+
+	if _, err := header.UpdateFile(path, docs(), false); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(path); string(data) == src {
+		t.Error("file was not updated")
+	}
+}
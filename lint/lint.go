@@ -0,0 +1,225 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+// Package lint checks documentation blocks against configurable rules.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+type Severity int
+
+const (
+	Info Severity = iota
+	Warning
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// MarshalText encodes the severity as its name.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes the severity from its name.
+func (s *Severity) UnmarshalText(text []byte) error {
+	for _, severity := range []Severity{Info, Warning, Error} {
+		if strings.EqualFold(string(text), severity.String()) {
+			*s = severity
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown severity '%s'", text)
+}
+
+type ErrUnknownRule struct {
+	Name string
+}
+
+func (err ErrUnknownRule) Error() string {
+	return fmt.Sprintf("unknown rule '%s'", err.Name)
+}
+
+// Diagnostic is a single finding reported by a rule.
+type Diagnostic struct {
+	Rule     string
+	Severity Severity
+	// Block and Command are indexes of the block passed to Lint and of the
+	// command in its Commands. Command is -1 for findings about the whole
+	// block.
+	Block   int
+	Command int
+	Message string
+}
+
+func (d Diagnostic) Error() string {
+	if d.Command < 0 {
+		return fmt.Sprintf("block %d: %s: %s (%s)", d.Block, d.Severity, d.Message, d.Rule)
+	}
+	return fmt.Sprintf("block %d, command %d: %s: %s (%s)", d.Block, d.Command, d.Severity, d.Message, d.Rule)
+}
+
+// Reporter records finding about the command at given index, or about the
+// whole block when the index is -1.
+type Reporter func(command int, format string, args ...interface{})
+
+// Check inspects the block and reports all findings. The configuration
+// carries options of the rules.
+type Check func(d *doxygen.Doxygen, c Config, report Reporter)
+
+// Rule is a named check with its default severity.
+type Rule struct {
+	Name        string
+	Description string
+	Severity    Severity
+	// Disabled rules are run only when enabled in the Config.
+	Disabled bool
+	Check    Check
+}
+
+var registry = map[string]Rule{}
+
+// Register adds the rule, replacing the rule registered under the same name.
+func Register(rule Rule) {
+	registry[rule.Name] = rule
+}
+
+// Rules returns all registered rules sorted by name.
+func Rules() []Rule {
+	rules := make([]Rule, 0, len(registry))
+	for _, rule := range registry {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Name < rules[j].Name
+	})
+	return rules
+}
+
+// DefaultMaxBriefLength is the number of characters allowed by the
+// brief-length rule, unless configured otherwise.
+const DefaultMaxBriefLength = 80
+
+// Config selects the rules, overrides their severities and sets their
+// options. Rules missing from Enabled keep their default state.
+type Config struct {
+	Enabled  map[string]bool     `json:"enabled,omitempty"`
+	Severity map[string]Severity `json:"severity,omitempty"`
+	// MaxBriefLength is the number of characters allowed by the brief-length
+	// rule. Zero means DefaultMaxBriefLength.
+	MaxBriefLength int `json:"maxBriefLength,omitempty"`
+}
+
+func (c Config) maxBriefLength() int {
+	if c.MaxBriefLength == 0 {
+		return DefaultMaxBriefLength
+	}
+	return c.MaxBriefLength
+}
+
+// Validate checks that the configuration refers only to registered rules.
+func (c Config) Validate() error {
+	var errs doxygen.Errors
+	for _, names := range [][]string{keys(c.Enabled), keys(c.Severity)} {
+		for _, name := range names {
+			if _, ok := registry[name]; !ok {
+				errs = append(errs, ErrUnknownRule{Name: name})
+			}
+		}
+	}
+	return errs.Err()
+}
+
+// Lint runs all enabled rules over the blocks. Diagnostics are ordered by
+// block, command and rule name.
+func (c Config) Lint(blocks ...*doxygen.Doxygen) []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, rule := range Rules() {
+		enabled, ok := c.Enabled[rule.Name]
+		if !ok {
+			enabled = !rule.Disabled
+		}
+		if !enabled {
+			continue
+		}
+
+		severity, ok := c.Severity[rule.Name]
+		if !ok {
+			severity = rule.Severity
+		}
+
+		for i, d := range blocks {
+			rule.Check(d, c, func(command int, format string, args ...interface{}) {
+				diagnostics = append(diagnostics, Diagnostic{
+					Rule:     rule.Name,
+					Severity: severity,
+					Block:    i,
+					Command:  command,
+					Message:  fmt.Sprintf(format, args...),
+				})
+			})
+		}
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		a, b := diagnostics[i], diagnostics[j]
+		if a.Block != b.Block {
+			return a.Block < b.Block
+		}
+		return a.Command < b.Command
+	})
+	return diagnostics
+}
+
+// Lint runs rules enabled by default over the blocks.
+func Lint(blocks ...*doxygen.Doxygen) []Diagnostic {
+	return Config{}.Lint(blocks...)
+}
+
+func keys[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
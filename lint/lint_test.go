@@ -0,0 +1,109 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package lint_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/lint"
+)
+
+func rules(diagnostics []lint.Diagnostic) []string {
+	var names []string
+	for _, d := range diagnostics {
+		names = append(names, d.Rule+":"+d.Severity.String())
+	}
+	return names
+}
+
+func TestLint(t *testing.T) {
+	good := doxygen.New(doxygen.WithMultipleCommands(
+		command.Brief{BriefDescription: "Opens a file."},
+		command.Param{Direction: "in", ParameterName: "path", ParameterDescription: "Path to the file."},
+	))
+	bad := doxygen.New(doxygen.WithMultipleCommands(
+		command.Param{ParameterName: "path"},
+		command.Deprecated{Description: "Use open2."},
+	))
+
+	got := rules(lint.Lint(good, bad))
+	want := []string{"brief-required:error", "param-description:error", "param-direction:warning", "deprecated-since:warning"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	config := lint.Config{
+		Enabled:  map[string]bool{"param-direction": false},
+		Severity: map[string]lint.Severity{"brief-required": lint.Warning},
+	}
+	got = rules(config.Lint(bad))
+	want = []string{"brief-required:warning", "param-description:error", "deprecated-since:warning"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	long := doxygen.New(doxygen.WithCommand(command.Brief{BriefDescription: strings.Repeat("a", 100) + "."}))
+	if got := rules(lint.Lint(long)); !reflect.DeepEqual(got, []string{"brief-length:warning"}) {
+		t.Errorf("got %v, want brief-length warning", got)
+	}
+	if got := rules(lint.Config{MaxBriefLength: 120}.Lint(long)); len(got) != 0 {
+		t.Errorf("got %v, want no diagnostics", got)
+	}
+
+	if err := (lint.Config{Enabled: map[string]bool{"no-such-rule": true}}).Validate(); err == nil {
+		t.Error("expected unknown rule error")
+	}
+}
+
+func TestCustomRule(t *testing.T) {
+	lint.Register(lint.Rule{
+		Name:     "no-todo",
+		Severity: lint.Info,
+		Disabled: true,
+		Check: func(d *doxygen.Doxygen, c lint.Config, report lint.Reporter) {
+			for i, cmd := range d.Commands {
+				if _, ok := cmd.(command.Todo); ok {
+					report(i, "unresolved todo")
+				}
+			}
+		},
+	})
+
+	d := doxygen.New(doxygen.WithMultipleCommands(
+		command.Brief{BriefDescription: "Reads " + strings.Repeat("a lot of ", 10) + "data"},
+		command.Todo{},
+	))
+	got := rules(lint.Config{Enabled: map[string]bool{"no-todo": true}}.Lint(d))
+	want := []string{"brief-length:warning", "brief-period:warning", "no-todo:info"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package lint
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+func init() {
+	for _, rule := range []Rule{
+		{
+			Name:        "brief-required",
+			Description: "block must have brief description",
+			Severity:    Error,
+			Check:       checkBriefRequired,
+		},
+		{
+			Name:        "brief-period",
+			Description: "brief description must end with a period",
+			Severity:    Warning,
+			Check:       checkBriefPeriod,
+		},
+		{
+			Name:        "brief-length",
+			Description: "brief description must not be longer than Config.MaxBriefLength",
+			Severity:    Warning,
+			Check:       checkBriefLength,
+		},
+		{
+			Name:        "param-description",
+			Description: "parameter must have description",
+			Severity:    Error,
+			Check:       checkParamDescription,
+		},
+		{
+			Name:        "param-direction",
+			Description: "parameter must have direction",
+			Severity:    Warning,
+			Check:       checkParamDirection,
+		},
+		{
+			Name:        "deprecated-since",
+			Description: "deprecated block must state since when",
+			Severity:    Warning,
+			Check:       checkDeprecatedSince,
+		},
+	} {
+		Register(rule)
+	}
+}
+
+// briefs returns indexes and texts of the brief descriptions of the block.
+func briefs(d *doxygen.Doxygen) map[int]string {
+	texts := map[int]string{}
+	for i, cmd := range d.Commands {
+		switch cmd := cmd.(type) {
+		case command.Brief:
+			texts[i] = strings.TrimSpace(cmd.BriefDescription)
+		case command.Short:
+			texts[i] = strings.TrimSpace(cmd.ShortDescription)
+		}
+	}
+	return texts
+}
+
+func checkBriefRequired(d *doxygen.Doxygen, c Config, report Reporter) {
+	if len(briefs(d)) == 0 {
+		report(-1, "missing brief description")
+	}
+}
+
+func checkBriefPeriod(d *doxygen.Doxygen, c Config, report Reporter) {
+	for i, text := range briefs(d) {
+		if text != "" && !strings.HasSuffix(text, ".") {
+			report(i, "brief description does not end with a period")
+		}
+	}
+}
+
+func checkBriefLength(d *doxygen.Doxygen, c Config, report Reporter) {
+	for i, text := range briefs(d) {
+		if n, limit := utf8.RuneCountInString(text), c.maxBriefLength(); n > limit {
+			report(i, "brief description is %d characters long, limit is %d", n, limit)
+		}
+	}
+}
+
+func checkParamDescription(d *doxygen.Doxygen, c Config, report Reporter) {
+	for i, cmd := range d.Commands {
+		if p, ok := cmd.(command.Param); ok && strings.TrimSpace(p.ParameterDescription) == "" {
+			report(i, "parameter '%s' has no description", p.ParameterName)
+		}
+	}
+}
+
+func checkParamDirection(d *doxygen.Doxygen, c Config, report Reporter) {
+	for i, cmd := range d.Commands {
+		if p, ok := cmd.(command.Param); ok && p.Direction == "" {
+			report(i, "parameter '%s' has no direction", p.ParameterName)
+		}
+	}
+}
+
+func checkDeprecatedSince(d *doxygen.Doxygen, c Config, report Reporter) {
+	deprecated, since := -1, false
+	for i, cmd := range d.Commands {
+		switch cmd.(type) {
+		case command.Deprecated:
+			deprecated = i
+		case command.Since:
+			since = true
+		}
+	}
+	if deprecated >= 0 && !since {
+		report(deprecated, "deprecated without since")
+	}
+}
@@ -0,0 +1,229 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package tagfile
+
+import (
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+// FromDoxygen builds a tag file from symbols declared by the blocks. Members
+// whose scope is not declared by any block are attached to the file compound
+// with the given name.
+//
+// Anchors of members are computed by Doxygen from data not available in the
+// blocks, so they are left empty and references to members link only to the
+// page of their compound.
+func FromDoxygen(file string, blocks ...*doxygen.Doxygen) *TagFile {
+	g := generator{
+		tf:    &TagFile{},
+		index: map[string]int{},
+		file:  file,
+	}
+	for _, d := range blocks {
+		g.compounds(d)
+	}
+	for _, d := range blocks {
+		g.members(d)
+	}
+	return g.tf
+}
+
+type generator struct {
+	tf    *TagFile
+	index map[string]int
+	file  string
+}
+
+// compound returns index of the compound, adding it if necessary.
+func (g *generator) compound(kind, name, title, filename string) int {
+	key := kind + ":" + name
+	if i, ok := g.index[key]; ok {
+		return i
+	}
+	g.index[key] = len(g.tf.Compounds)
+	g.tf.Compounds = append(g.tf.Compounds, Compound{
+		Kind:     kind,
+		Name:     name,
+		Title:    title,
+		Filename: filename,
+	})
+	return len(g.tf.Compounds) - 1
+}
+
+func (g *generator) lookup(name string) (int, bool) {
+	for i := range g.tf.Compounds {
+		if g.tf.Compounds[i].Name == name && g.tf.Compounds[i].Kind != "file" {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// compounds adds compounds declared by the block, and anchors defined within
+// them.
+func (g *generator) compounds(d *doxygen.Doxygen) {
+	current := -1
+	for _, cmd := range d.Commands {
+		switch cmd := cmd.(type) {
+		case command.Class:
+			current = g.compound("class", cmd.Name, "", "class"+escape(cmd.Name)+".html")
+		case command.Struct:
+			current = g.compound("struct", cmd.Name, "", "struct"+escape(cmd.Name)+".html")
+		case command.Union:
+			current = g.compound("union", cmd.Name, "", "union"+escape(cmd.Name)+".html")
+		case command.Interface:
+			current = g.compound("interface", cmd.Name, "", "interface"+escape(cmd.Name)+".html")
+		case command.Protocol:
+			current = g.compound("protocol", cmd.Name, "", "protocol"+escape(cmd.Name)+".html")
+		case command.Namespace:
+			current = g.compound("namespace", cmd.Name, "", "namespace"+escape(cmd.Name)+".html")
+		case command.File:
+			if cmd.Name != "" {
+				current = g.compound("file", cmd.Name, "", escape(cmd.Name)+".html")
+			}
+		case command.Page:
+			current = g.compound("page", cmd.Name, cmd.Title, cmd.Name+".html")
+		case command.Mainpage:
+			current = g.compound("page", "index", cmd.Title, "index.html")
+		case command.Defgroup:
+			current = g.compound("group", cmd.Name, cmd.GroupTitle, "group__"+escape(cmd.Name)+".html")
+		case command.Anchor:
+			g.anchor(current, cmd.Name, cmd.Text)
+		case command.Section:
+			g.anchor(current, cmd.Name, cmd.Title)
+		case command.Subsection:
+			g.anchor(current, cmd.Name, cmd.Title)
+		case command.Subsubsection:
+			g.anchor(current, cmd.Name, cmd.Title)
+		}
+	}
+}
+
+func (g *generator) anchor(i int, name, title string) {
+	if i < 0 {
+		return
+	}
+	c := &g.tf.Compounds[i]
+	c.DocAnchors = append(c.DocAnchors, DocAnchor{File: c.Filename, Title: title, Name: name})
+}
+
+// members adds members declared by the block to their scopes.
+func (g *generator) members(d *doxygen.Doxygen) {
+	for _, cmd := range d.Commands {
+		var m Member
+		switch cmd := cmd.(type) {
+		case command.Fn:
+			m = Member{
+				Kind:    "function",
+				Type:    cmd.ReturnType,
+				Name:    cmd.Name,
+				Arglist: "(" + strings.Join(cmd.Parameters, ", ") + ")" + optional(cmd.Qualifiers),
+			}
+		case command.Var:
+			m = Member{Kind: "variable", Type: cmd.Datatype, Name: cmd.Name}
+		case command.Property:
+			m = Member{Kind: "property", Type: cmd.Datatype, Name: cmd.Name}
+		case command.Typedef:
+			m = Member{Kind: "typedef", Type: cmd.Type, Name: cmd.Name}
+		case command.Enum:
+			m = Member{Kind: "enumeration", Name: cmd.Name}
+		case command.Def:
+			m = Member{Kind: "define", Name: cmd.Name}
+		default:
+			continue
+		}
+		g.member(m)
+	}
+}
+
+func (g *generator) member(m Member) {
+	qualified := strings.TrimPrefix(m.Name, "::")
+	scope := g.file
+	var index int
+	if i := strings.LastIndex(qualified, "::"); i >= 0 {
+		scope, m.Name = qualified[:i], qualified[i+2:]
+		var ok bool
+		if index, ok = g.lookup(scope); !ok {
+			index = g.compound("namespace", scope, "", "namespace"+escape(scope)+".html")
+		}
+	} else {
+		m.Name = qualified
+		index = g.compound("file", scope, "", escape(scope)+".html")
+	}
+	c := &g.tf.Compounds[index]
+
+	m.AnchorFile = c.Filename
+	c.Members = append(c.Members, m)
+}
+
+// escape converts a name into a file name the same way Doxygen does.
+func escape(name string) string {
+	sb := strings.Builder{}
+	for i := 0; i < len(name); i++ {
+		switch ch := name[i]; ch {
+		case '_':
+			sb.WriteString("__")
+		case '-':
+			sb.WriteString("-")
+		case ':':
+			sb.WriteString("_1")
+		case '/':
+			sb.WriteString("_2")
+		case '<':
+			sb.WriteString("_3")
+		case '>':
+			sb.WriteString("_4")
+		case '*':
+			sb.WriteString("_5")
+		case '&':
+			sb.WriteString("_6")
+		case '|':
+			sb.WriteString("_7")
+		case '.':
+			sb.WriteString("_8")
+		case '!':
+			sb.WriteString("_9")
+		case ',':
+			sb.WriteString("_00")
+		case ' ':
+			sb.WriteString("_01")
+		default:
+			sb.WriteByte(ch)
+		}
+	}
+	return sb.String()
+}
+
+func optional(s string) string {
+	if s != "" {
+		return " " + s
+	}
+	return ""
+}
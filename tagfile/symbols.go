@@ -0,0 +1,92 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package tagfile
+
+import "sort"
+
+// Symbol is a single linkable entity found in the tag file.
+type Symbol struct {
+	Kind string
+	// Name is fully qualified, e.g. `ns::Widget::resize` for members.
+	Name   string
+	File   string
+	Anchor string
+}
+
+// Symbols returns all compounds, members and documentation anchors sorted by
+// name. Members listed in many compounds, e.g. in a file and in a group, are
+// returned once.
+func (tf *TagFile) Symbols() []Symbol {
+	seen := map[string]bool{}
+	var symbols []Symbol
+	add := func(sym Symbol) {
+		if !seen[sym.Name] {
+			seen[sym.Name] = true
+			symbols = append(symbols, sym)
+		}
+	}
+
+	for _, c := range tf.Compounds {
+		add(Symbol{Kind: c.Kind, Name: c.Name, File: c.Filename})
+	}
+	for _, c := range tf.Compounds {
+		for _, m := range c.Members {
+			name := m.Name
+			if scoped(c.Kind) {
+				name = c.Name + "::" + m.Name
+			}
+			add(Symbol{Kind: m.Kind, Name: name, File: m.AnchorFile, Anchor: m.Anchor})
+		}
+		for _, a := range c.DocAnchors {
+			add(Symbol{Kind: "anchor", Name: a.Name, File: a.File, Anchor: a.Name})
+		}
+	}
+
+	sort.Slice(symbols, func(i, j int) bool {
+		return symbols[i].Name < symbols[j].Name
+	})
+	return symbols
+}
+
+// Table returns all symbols by name.
+func (tf *TagFile) Table() map[string]Symbol {
+	table := map[string]Symbol{}
+	for _, sym := range tf.Symbols() {
+		table[sym.Name] = sym
+	}
+	return table
+}
+
+// scoped reports whether members of the compound kind are referenced by name
+// qualified with the compound name.
+func scoped(kind string) bool {
+	switch kind {
+	case "class", "struct", "union", "interface", "protocol", "category", "namespace":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+// Package tagfile reads and writes Doxygen tag files, used to link
+// documentation of separate projects.
+package tagfile
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// TagFile is the root element of a Doxygen tag file.
+//
+// For more details, see: https://doxygen.nl/manual/external.html
+type TagFile struct {
+	XMLName        xml.Name   `xml:"tagfile"`
+	DoxygenVersion string     `xml:"doxygen_version,attr,omitempty"`
+	Compounds      []Compound `xml:"compound"`
+}
+
+// Compound is a documented entity with its own page, such as a class,
+// a namespace or a file.
+type Compound struct {
+	Kind       string      `xml:"kind,attr"`
+	Name       string      `xml:"name"`
+	Title      string      `xml:"title,omitempty"`
+	Path       string      `xml:"path,omitempty"`
+	Filename   string      `xml:"filename"`
+	Bases      []string    `xml:"base,omitempty"`
+	Classes    []Class     `xml:"class,omitempty"`
+	Namespaces []string    `xml:"namespace,omitempty"`
+	Files      []string    `xml:"file,omitempty"`
+	Subgroups  []string    `xml:"subgroup,omitempty"`
+	Subpages   []string    `xml:"subpage,omitempty"`
+	Members    []Member    `xml:"member,omitempty"`
+	DocAnchors []DocAnchor `xml:"docanchor,omitempty"`
+}
+
+// Class is a reference to a class compound nested in another compound.
+type Class struct {
+	Kind string `xml:"kind,attr"`
+	Name string `xml:",chardata"`
+}
+
+// Member is a function, variable, typedef or other member of a compound.
+type Member struct {
+	Kind       string `xml:"kind,attr"`
+	Type       string `xml:"type"`
+	Name       string `xml:"name"`
+	AnchorFile string `xml:"anchorfile"`
+	// Anchor is empty in tag files built by FromDoxygen.
+	Anchor  string `xml:"anchor"`
+	Arglist string `xml:"arglist"`
+}
+
+// DocAnchor is an anchor or a section defined in documentation of a compound.
+type DocAnchor struct {
+	File  string `xml:"file,attr"`
+	Title string `xml:"title,attr,omitempty"`
+	Name  string `xml:",chardata"`
+}
+
+// Parse reads a tag file.
+func Parse(r io.Reader) (*TagFile, error) {
+	tf := &TagFile{}
+	if err := xml.NewDecoder(r).Decode(tf); err != nil {
+		return nil, err
+	}
+	return tf, nil
+}
+
+// Write writes the tag file in the format read by Doxygen's TAGFILES option.
+func (tf *TagFile) Write(w io.Writer) error {
+	if _, err := io.WriteString(w, "<?xml version='1.0' encoding='UTF-8' standalone='yes' ?>\n"); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(tf); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
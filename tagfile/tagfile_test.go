@@ -0,0 +1,104 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package tagfile_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/tagfile"
+)
+
+const sample = `<?xml version='1.0' encoding='UTF-8' standalone='yes' ?>
+<tagfile doxygen_version="1.9.8">
+  <compound kind="class">
+    <name>ns::Widget</name>
+    <filename>classns_1_1Widget.html</filename>
+    <member kind="function">
+      <type>void</type>
+      <name>resize</name>
+      <anchorfile>classns_1_1Widget.html</anchorfile>
+      <anchor>a5e6f0c2b</anchor>
+      <arglist>(int w, int h)</arglist>
+    </member>
+  </compound>
+  <compound kind="page">
+    <name>intro</name>
+    <title>Introduction</title>
+    <filename>intro.html</filename>
+    <docanchor file="intro.html" title="Usage">usage</docanchor>
+  </compound>
+</tagfile>
+`
+
+func TestParse(t *testing.T) {
+	tf, err := tagfile.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, sym := range tf.Symbols() {
+		names = append(names, sym.Name)
+	}
+	want := []string{"intro", "ns::Widget", "ns::Widget::resize", "usage"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("unexpected symbols: %v", names)
+	}
+	if sym := tf.Table()["ns::Widget::resize"]; sym.File != "classns_1_1Widget.html" || sym.Anchor != "a5e6f0c2b" {
+		t.Errorf("unexpected member: %+v", sym)
+	}
+}
+
+func TestFromDoxygen(t *testing.T) {
+	tf := tagfile.FromDoxygen("io.h",
+		doxygen.New(doxygen.WithCommand(command.Class{Name: "ns::Widget"})),
+		doxygen.New(doxygen.WithCommand(command.Fn{ReturnType: "void", Name: "ns::Widget::resize", Parameters: []string{"int w", "int h"}})),
+		doxygen.New(doxygen.WithCommand(command.Fn{ReturnType: "int", Name: "io_open"})),
+	)
+
+	buf := bytes.Buffer{}
+	if err := tf.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := tagfile.Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := parsed.Table()
+	if sym := table["ns::Widget::resize"]; sym.Kind != "function" || sym.File != "classns_1_1Widget.html" {
+		t.Errorf("unexpected member: %+v", sym)
+	}
+	if sym := table["io_open"]; sym.File != "io_8h.html" || sym.Anchor != "" {
+		t.Errorf("unexpected global member: %+v", sym)
+	}
+}
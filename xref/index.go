@@ -0,0 +1,173 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package xref
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/tagfile"
+)
+
+// Symbol is a single reference target.
+type Symbol struct {
+	Kind string
+	Name string
+	// External is set for symbols coming from other projects, e.g. from tag
+	// files.
+	External bool
+}
+
+// Index holds all known reference targets.
+type Index struct {
+	symbols map[string]Symbol
+}
+
+func NewIndex() *Index {
+	return &Index{
+		symbols: map[string]Symbol{},
+	}
+}
+
+// Add adds a symbol to the index. Symbols declared by generated blocks take
+// precedence over external ones with the same name.
+func (ix *Index) Add(sym Symbol) {
+	if existing, ok := ix.symbols[sym.Name]; ok && !existing.External {
+		return
+	}
+	ix.symbols[sym.Name] = sym
+}
+
+// AddDoxygen adds all symbols declared by the blocks.
+func (ix *Index) AddDoxygen(blocks ...*doxygen.Doxygen) {
+	for _, d := range blocks {
+		for _, cmd := range d.Commands {
+			if kind, name, ok := command.Declares(cmd); ok && name != "" {
+				ix.Add(Symbol{Kind: kind, Name: name})
+			}
+		}
+	}
+}
+
+// AddTagFile adds all symbols of a tag file as external symbols.
+func (ix *Index) AddTagFile(tf *tagfile.TagFile) {
+	for _, sym := range tf.Symbols() {
+		ix.Add(Symbol{Kind: sym.Kind, Name: sym.Name, External: true})
+	}
+}
+
+// Lookup returns the symbol referenced by name. Argument lists, such as in
+// `open()` or `open(const char*)`, and leading `::` are ignored.
+func (ix *Index) Lookup(name string) (Symbol, bool) {
+	sym, ok := ix.symbols[normalize(name)]
+	return sym, ok
+}
+
+// Symbols returns all symbols sorted by name.
+func (ix *Index) Symbols() []Symbol {
+	symbols := make([]Symbol, 0, len(ix.symbols))
+	for _, sym := range ix.symbols {
+		symbols = append(symbols, sym)
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		return symbols[i].Name < symbols[j].Name
+	})
+	return symbols
+}
+
+// Suggest returns up to n names closest to the given one, for reporting
+// likely typos.
+func (ix *Index) Suggest(name string, n int) []string {
+	name = normalize(name)
+	limit := len(name) / 3
+	if limit < 2 {
+		limit = 2
+	}
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for other := range ix.symbols {
+		if d := distance(strings.ToLower(name), strings.ToLower(other)); d <= limit {
+			candidates = append(candidates, candidate{name: other, distance: d})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	var names []string
+	for i := 0; i < len(candidates) && i < n; i++ {
+		names = append(names, candidates[i].name)
+	}
+	return names
+}
+
+func normalize(name string) string {
+	if i := strings.Index(name, "("); i >= 0 {
+		name = name[:i]
+	}
+	return strings.TrimPrefix(strings.TrimSpace(name), "::")
+}
+
+// distance returns Levenshtein distance between a and b.
+func distance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minimum(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minimum(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
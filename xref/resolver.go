@@ -0,0 +1,203 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package xref
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+)
+
+// Problem is a single reference that could not be resolved, or a cycle of
+// copied documentation.
+type Problem struct {
+	// Block and Command are indexes of the block passed to Resolve and of the
+	// command in its Commands.
+	Block       int
+	Command     int
+	Target      string
+	Message     string
+	Suggestions []string
+}
+
+func (p Problem) Error() string {
+	msg := fmt.Sprintf("block %d, command %d: %s", p.Block, p.Command, p.Message)
+	if len(p.Suggestions) > 0 {
+		msg += fmt.Sprintf(" (did you mean %s?)", strings.Join(p.Suggestions, ", "))
+	}
+	return msg
+}
+
+// MaxSuggestions is the number of close matches reported for each unresolved
+// reference.
+const MaxSuggestions = 3
+
+// Targets returns names referenced by the command, e.g. reference of Ref or
+// all references of See.
+func Targets(cmd command.Command) []string {
+	switch cmd := cmd.(type) {
+	case command.Ref:
+		return []string{cmd.Name}
+	case command.Link:
+		return []string{cmd.LinkObject}
+	case command.Sa:
+		return cmd.References
+	case command.See:
+		return cmd.References
+	case command.Copybrief:
+		return []string{cmd.LinkObject}
+	case command.Copydetails:
+		return []string{cmd.LinkObject}
+	case command.Copydoc:
+		return []string{cmd.LinkObject}
+	case command.Relates:
+		return []string{cmd.Name}
+	case command.Relatesalso:
+		return []string{cmd.Name}
+	case command.Related:
+		return []string{cmd.Name}
+	case command.Relatedalso:
+		return []string{cmd.Name}
+	case command.Memberof:
+		return []string{cmd.Name}
+	case command.Refitem:
+		return []string{cmd.Name}
+	case command.Subpage:
+		return []string{cmd.Name}
+	}
+	return nil
+}
+
+// Resolve checks targets of all references in the blocks, and reports cycles
+// of Copydoc, Copybrief and Copydetails commands.
+func (ix *Index) Resolve(blocks ...*doxygen.Doxygen) []Problem {
+	var problems []Problem
+	for b, d := range blocks {
+		for c, cmd := range d.Commands {
+			for _, target := range Targets(cmd) {
+				if _, ok := ix.Lookup(target); ok {
+					continue
+				}
+				problems = append(problems, Problem{
+					Block:       b,
+					Command:     c,
+					Target:      target,
+					Message:     fmt.Sprintf("%s target '%s' is not defined", command.NameOf(cmd), target),
+					Suggestions: ix.Suggest(target, MaxSuggestions),
+				})
+			}
+		}
+	}
+	return append(problems, copyCycles(blocks)...)
+}
+
+type copyEdge struct {
+	target  string
+	block   int
+	command int
+}
+
+// documented returns normalized name of the entity documented by the block.
+// Anchors and sections only mark places within its documentation.
+func documented(d *doxygen.Doxygen) string {
+	for _, cmd := range d.Commands {
+		kind, name, ok := command.Declares(cmd)
+		if ok && kind != command.KindAnchor && kind != command.KindSection {
+			return normalize(name)
+		}
+	}
+	return ""
+}
+
+func copyCycles(blocks []*doxygen.Doxygen) []Problem {
+	edges := map[string][]copyEdge{}
+	var order []string
+	for b, d := range blocks {
+		subject := documented(d)
+		if subject == "" {
+			continue
+		}
+		for c, cmd := range d.Commands {
+			switch cmd.(type) {
+			case command.Copydoc, command.Copybrief, command.Copydetails:
+				if _, ok := edges[subject]; !ok {
+					order = append(order, subject)
+				}
+				edges[subject] = append(edges[subject], copyEdge{
+					target:  normalize(Targets(cmd)[0]),
+					block:   b,
+					command: c,
+				})
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var path []string
+	var problems []Problem
+
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		path = append(path, name)
+		for _, edge := range edges[name] {
+			switch state[edge.target] {
+			case unvisited:
+				visit(edge.target)
+			case visiting:
+				for i := range path {
+					if path[i] == edge.target {
+						cycle := append(append([]string{}, path[i:]...), edge.target)
+						problems = append(problems, Problem{
+							Block:   edge.block,
+							Command: edge.command,
+							Target:  edge.target,
+							Message: fmt.Sprintf("documentation is copied in a cycle: %s", strings.Join(cycle, " -> ")),
+						})
+						break
+					}
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+	}
+
+	for _, name := range order {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+	return problems
+}
@@ -0,0 +1,66 @@
+/*
+This is free and unencumbered software released into the public domain.
+
+Anyone is free to copy, modify, publish, use, compile, sell, or
+distribute this software, either in source code form or as a compiled
+binary, for any purpose, commercial or non-commercial, and by any
+means.
+
+In jurisdictions that recognize copyright laws, the author or authors
+of this software dedicate any and all copyright interest in the
+software to the public domain. We make this dedication for the benefit
+of the public at large and to the detriment of our heirs and
+successors. We intend this dedication to be an overt act of
+relinquishment in perpetuity of all present and future rights to this
+software under copyright law.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+IN NO EVENT SHALL THE AUTHORS BE LIABLE FOR ANY CLAIM, DAMAGES OR
+OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+OTHER DEALINGS IN THE SOFTWARE.
+
+For more information, please refer to <https://unlicense.org>
+*/
+package xref_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shanduur/go-doxygen-generator/command"
+	"github.com/shanduur/go-doxygen-generator/doxygen"
+	"github.com/shanduur/go-doxygen-generator/xref"
+)
+
+func TestResolve(t *testing.T) {
+	open := doxygen.New(
+		doxygen.WithCommand(command.Fn{ReturnType: "int", Name: "file_open"}),
+		doxygen.WithCommand(command.See{References: []string{"file_close()", "file_clsoe"}}),
+	)
+	closeFn := doxygen.New(
+		doxygen.WithCommand(command.Fn{ReturnType: "int", Name: "file_close"}),
+		doxygen.WithCommand(command.Copydoc{LinkObject: "file_read"}),
+	)
+	read := doxygen.New(
+		doxygen.WithCommand(command.Anchor{Name: "reading"}),
+		doxygen.WithCommand(command.Fn{ReturnType: "int", Name: "file_read"}),
+		doxygen.WithCommand(command.Copydoc{LinkObject: "file_close"}),
+	)
+
+	ix := xref.NewIndex()
+	ix.AddDoxygen(open, closeFn, read)
+	problems := ix.Resolve(open, closeFn, read)
+
+	if len(problems) != 2 {
+		t.Fatalf("expected 2 problems, got %v", problems)
+	}
+	if p := problems[0]; p.Target != "file_clsoe" || !reflect.DeepEqual(p.Suggestions, []string{"file_close"}) {
+		t.Errorf("unexpected problem: %v", p)
+	}
+	if p := problems[1]; p.Block != 2 || p.Target != "file_close" {
+		t.Errorf("expected copydoc cycle, got: %v", p)
+	}
+}